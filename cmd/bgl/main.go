@@ -3,16 +3,41 @@ package main
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dannygim/bgl/internal/attachment"
 	"github.com/dannygim/bgl/internal/auth"
+	"github.com/dannygim/bgl/internal/bugreport"
 	"github.com/dannygim/bgl/internal/category"
 	"github.com/dannygim/bgl/internal/comment"
+	"github.com/dannygim/bgl/internal/complete"
+	"github.com/dannygim/bgl/internal/config"
+	"github.com/dannygim/bgl/internal/file"
 	"github.com/dannygim/bgl/internal/issue"
 	"github.com/dannygim/bgl/internal/issuetype"
 	"github.com/dannygim/bgl/internal/milestone"
+	"github.com/dannygim/bgl/internal/next"
+	"github.com/dannygim/bgl/internal/notification"
+	"github.com/dannygim/bgl/internal/pr"
+	"github.com/dannygim/bgl/internal/priority"
+	"github.com/dannygim/bgl/internal/project"
+	"github.com/dannygim/bgl/internal/queue"
+	"github.com/dannygim/bgl/internal/ratelimit"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/repo"
+	"github.com/dannygim/bgl/internal/resolution"
+	"github.com/dannygim/bgl/internal/setup"
+	"github.com/dannygim/bgl/internal/space"
+	"github.com/dannygim/bgl/internal/star"
 	"github.com/dannygim/bgl/internal/status"
+	"github.com/dannygim/bgl/internal/team"
+	"github.com/dannygim/bgl/internal/usage"
+	"github.com/dannygim/bgl/internal/user"
+	"github.com/dannygim/bgl/internal/watching"
+	"github.com/dannygim/bgl/internal/webhook"
+	"github.com/dannygim/bgl/internal/wiki"
 )
 
 var (
@@ -22,11 +47,29 @@ var (
 )
 
 func main() {
+	if err := parseGlobalFlags(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := checkRequiredSpace(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	if len(os.Args) < 2 {
+		if !config.Exists() {
+			fmt.Println("No configuration found. Run 'bgl init' to get started.")
+			fmt.Println()
+		}
 		printUsage()
 		os.Exit(0)
 	}
 
+	// Internal commands run from shell hooks are not counted
+	if !strings.HasPrefix(os.Args[1], "__") {
+		usage.RecordCommand(commandName(os.Args[1:]))
+	}
+
 	switch os.Args[1] {
 	case "-h", "--help", "help":
 		printUsage()
@@ -34,6 +77,8 @@ func main() {
 		fmt.Printf("bgl version %s\n", version)
 		fmt.Printf("  commit: %s\n", commit)
 		fmt.Printf("  built:  %s\n", date)
+	case "init":
+		handleInit()
 	case "auth":
 		handleAuth()
 	case "issue":
@@ -50,6 +95,48 @@ func main() {
 		handleMilestone()
 	case "issuetype":
 		handleIssueType()
+	case "priority":
+		handlePriority()
+	case "resolution":
+		handleResolution()
+	case "project":
+		handleProject()
+	case "user":
+		handleUser()
+	case "space":
+		handleSpace()
+	case "notification":
+		handleNotification()
+	case "watching":
+		handleWatching()
+	case "star":
+		handleStar()
+	case "team":
+		handleTeam()
+	case "webhook":
+		handleWebhook()
+	case "file":
+		handleFile()
+	case "wiki":
+		handleWiki()
+	case "repo":
+		handleRepo()
+	case "pr":
+		handlePR()
+	case "quick":
+		handleQuick()
+	case "next":
+		handleNext()
+	case "ratelimit":
+		handleRateLimit()
+	case "queue":
+		handleQueue()
+	case "stats":
+		handleStats()
+	case "bugreport":
+		handleBugreport()
+	case "__complete":
+		handleComplete()
 	default:
 		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", os.Args[1])
 		printUsage()
@@ -57,6 +144,106 @@ func main() {
 	}
 }
 
+// commandGroups lists the commands whose first argument is a subcommand.
+var commandGroups = map[string]bool{
+	"auth": true, "issue": true, "comment": true, "attachment": true, "status": true, "category": true,
+	"milestone": true, "issuetype": true, "priority": true, "resolution": true, "project": true, "user": true,
+	"space": true, "notification": true, "watching": true, "star": true, "team": true, "webhook": true,
+	"file": true, "wiki": true, "repo": true, "pr": true, "queue": true,
+}
+
+// requiredSpace is the space given with the global --require-space flag.
+var requiredSpace string
+
+// parseGlobalFlags applies and removes the global --render and
+// --require-space flags. They are only recognized before the first
+// positional argument after the command and subcommand, and never after a
+// "--" separator, so a message or query that happens to contain "--render"
+// is passed through untouched.
+func parseGlobalFlags() error {
+	args := []string{os.Args[0]}
+	command, words := "", 0
+	i := 1
+	for ; i < len(os.Args); i++ {
+		arg := os.Args[i]
+		if arg == "--" {
+			break
+		}
+		name, value, hasValue := strings.Cut(arg, "=")
+		switch name {
+		case "--render", "--require-space":
+			if !hasValue {
+				if i+1 >= len(os.Args) {
+					return fmt.Errorf("%s requires a value", name)
+				}
+				i++
+				value = os.Args[i]
+			}
+			if name == "--require-space" {
+				requiredSpace = value
+			} else if err := render.SetMode(value); err != nil {
+				return err
+			}
+			continue
+		}
+		if strings.HasPrefix(arg, "-") {
+			args = append(args, arg)
+			continue
+		}
+		// The first word is the command and, for command groups, the second
+		// is the subcommand; any other word is a positional argument.
+		if words == 0 || (words == 1 && commandGroups[command]) {
+			if words == 0 {
+				command = arg
+			}
+			words++
+			args = append(args, arg)
+			continue
+		}
+		break
+	}
+	os.Args = append(args, os.Args[i:]...)
+	return nil
+}
+
+// checkRequiredSpace fails unless the configured space is the one given with
+// --require-space, so scripts that skip confirmation with --yes cannot
+// post to another space by accident.
+func checkRequiredSpace() error {
+	if requiredSpace == "" {
+		return nil
+	}
+	want := strings.TrimSuffix(strings.TrimPrefix(requiredSpace, "https://"), "/")
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	if cfg.Space == "" {
+		return fmt.Errorf("no space is configured, but --require-space is %s", want)
+	}
+	if !strings.EqualFold(cfg.Space, want) {
+		return fmt.Errorf("configured space is %s, not %s (--require-space)", cfg.Space, want)
+	}
+	return nil
+}
+
+// commandName returns the command and subcommand for usage counting,
+// e.g. "issue view". Arguments and flags are never recorded.
+func commandName(args []string) string {
+	name := args[0]
+	if len(args) > 1 && !strings.HasPrefix(args[1], "-") && commandGroups[name] {
+		name += " " + args[1]
+	}
+	return name
+}
+
+// fail records the error category, prints the error, and exits.
+func fail(err error) {
+	usage.RecordError(err)
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	os.Exit(1)
+}
+
 func printUsage() {
 	fmt.Println("bgl - A command line tool for Backlog")
 	fmt.Println()
@@ -64,29 +251,163 @@ func printUsage() {
 	fmt.Println("  bgl <command>")
 	fmt.Println()
 	fmt.Println("Commands:")
+	fmt.Println("  init                    Set up bgl interactively")
 	fmt.Println("  auth login              Login to Backlog using OAuth 2.0")
 	fmt.Println("  auth logout             Logout and remove stored tokens")
+	fmt.Println("  auth status [--raw]     Check that the stored login works")
 	fmt.Println("  issue view [--raw] <issueKey>   View an issue by key or ID")
+	fmt.Println("  issue list [--raw] [--tsv] [--project=<projectIdOrKey>]   List issues")
 	fmt.Println("  issue add [--raw] [--yes] --project=<projectIdOrKey> [options]   Create a new issue")
 	fmt.Println("  issue update [--raw] [options] <issueKey>   Update an issue")
+	fmt.Println("  issue prs [--raw] <issueKey>   List pull requests linked to an issue")
+	fmt.Println("  issue history [--raw] [--field=<field> [--diff]] <issueKey>   Show an issue's change history")
+	fmt.Println("  issue reopen [--raw] [--comment=<text>] <issueKey>   Reopen a closed issue")
+	fmt.Println("  issue resolution [--raw] <issueKey> <resolution>   Set an issue's resolution")
 	fmt.Println("  comment view [--raw] <issueKey> [commentId]   View comments for an issue")
+	fmt.Println("  comment latest [--raw] [-n <count>] <issueKey>   View the most recent comments")
+	fmt.Println("  comment search [--raw] <issueKey> <query>   Search an issue's comments")
 	fmt.Println("  comment add [--raw] [--yes] <issueKey> [message]   Add a comment to an issue")
+	fmt.Println("  comment reply [--raw] [--yes] <issueKey> <commentId>   Reply to a comment with a quote")
+	fmt.Println("  comment edit [--raw] [--yes] <issueKey> <commentId> [message]   Edit a comment")
+	fmt.Println("  comment delete [--raw] [--yes] <issueKey> <commentId>   Delete a comment")
+	fmt.Println("  comment notifications [--raw] <issueKey> <commentId>   Show who was notified about a comment")
+	fmt.Println("  comment count [--raw] <issueKey>   Print the number of comments on an issue")
+	fmt.Println("  comment url <issueKey> <commentId>   Print the link to a comment")
 	fmt.Println("  attachment list [--raw] <issueKey>   List attachments for an issue")
 	fmt.Println("  attachment download [-o <path>] <issueKey> <attachmentId>   Download an issue's attachment")
+	fmt.Println("  attachment download-all [--dir <path>] <issueKey>   Download all of an issue's attachments")
 	fmt.Println("  status list [--raw] <projectId>   List statuses for a project")
+	fmt.Println("  status add --name=<name> --color=<color> <projectId>   Add a status")
+	fmt.Println("  status edit [--name=<name>] [--color=<color>] <projectId> <status>   Update a status")
+	fmt.Println("  status rm --substitute=<status> <projectId> <status>   Delete a status")
+	fmt.Println("  status order <projectId> <status>...   Reorder statuses")
 	fmt.Println("  category list [--raw] <projectId>   List categories for a project")
+	fmt.Println("  category add [--yes] <projectId> <name>   Add a category")
+	fmt.Println("  category rename [--yes] <projectId> <category> <newName>   Rename a category")
+	fmt.Println("  category delete [--yes] <projectId> <category>   Delete a category")
 	fmt.Println("  milestone list [--raw] <projectId>   List versions/milestones for a project")
+	fmt.Println("  milestone add [options] <projectId> <name>   Add a version/milestone")
+	fmt.Println("  milestone edit [options] <projectId> <milestone>   Update a version/milestone")
+	fmt.Println("  milestone close [--yes] <projectId> <milestone>   Archive a version/milestone")
 	fmt.Println("  issuetype list [--raw] <projectId>   List issue types for a project")
+	fmt.Println("  issuetype add --name=<name> --color=<color> <projectId>   Add an issue type")
+	fmt.Println("  issuetype delete --substitute=<type> <projectId> <type>   Delete an issue type")
+	fmt.Println("  priority list [--raw]   List priorities")
+	fmt.Println("  resolution list [--raw]   List resolutions")
+	fmt.Println("  project list [--raw]    List projects")
+	fmt.Println("  project view [--raw] [projectKey]   View a project's settings")
+	fmt.Println("  project onboard [--yes] --user=<user> <projectKey>   Add a member with an onboarding issue")
+	fmt.Println("  project fields [--raw] [projectKey]   List a project's custom fields")
+	fmt.Println("  project activity [--raw] [--limit <n>] [--type <types>] [projectKey]   Show a project's recent activity")
+	fmt.Println("  project disk-usage [--raw] [projectKey]   Show a project's disk usage")
+	fmt.Println("  project create [--yes] --key <key> --name <name> [options]   Create a project")
+	fmt.Println("  project edit [--yes] [options] <projectKey>   Update a project's settings")
+	fmt.Println("  project user add|remove [--yes] <projectKey> <user>   Add or remove a project member")
+	fmt.Println("  project teams [--raw] [projectKey]   List teams attached to a project")
+	fmt.Println("  user me [--raw]         Show the logged-in user")
+	fmt.Println("  user list [--raw] [--query <text>]   List users in the space")
+	fmt.Println("  user view [--raw] <user>   View a user")
+	fmt.Println("  user activity [--raw] [--limit <n>] [user]   Show a user's recent activity")
+	fmt.Println("  user icon [-o <file>] <user>   Download a user's icon")
+	fmt.Println("  space info [--raw]      Show the space's name, owner, and settings")
+	fmt.Println("  space activity [--raw] [--limit <n>] [--type <types>]   Show recent activity across all projects")
+	fmt.Println("  space notification [--raw] [--yes] [--set <text>]   Show or set the space notification banner")
+	fmt.Println("  space disk-usage [--raw]   Show the space's disk usage, in total and per project")
+	fmt.Println("  space licence [--raw]   Show the space licence's limits and current usage")
+	fmt.Println("  space capabilities [--raw] [--refresh]   Show which plan features the space has")
+	fmt.Println("  notification list [--raw] [--unread] [--limit <n>]   List your notifications")
+	fmt.Println("  notification count [--raw] [--unread]   Print the number of notifications")
+	fmt.Println("  notification watch [--interval <duration>] [--desktop]   Print new notifications as they arrive")
+	fmt.Println("  watching list [--raw] [--unread]   List the issues you are watching")
+	fmt.Println("  watching add [--note <text>] <issueKey>   Watch an issue")
+	fmt.Println("  watching remove [--yes] <watching>   Stop watching an issue")
+	fmt.Println("  watching note <watching> <text>   Set the note on a watching")
+	fmt.Println("  star list [--raw] [--since <date>] [--limit <n>] [user]   List stars a user received")
+	fmt.Println("  star count [--raw] [--since <date>] [--until <date>] [user]   Count stars a user received")
+	fmt.Println("  team list [--raw]       List teams in the space")
+	fmt.Println("  team view [--raw] <team>   View a team and its members")
+	fmt.Println("  webhook list [--raw] <projectId>   List webhooks for a project")
+	fmt.Println("  webhook add [options] --name=<name> --url=<url> <projectId>   Add a webhook")
+	fmt.Println("  webhook delete [--yes] <projectId> <webhook>   Delete a webhook")
+	fmt.Println("  file list [--raw] <projectKey> [path]   List shared files in a directory")
+	fmt.Println("  file download [-d <dir>] <projectKey> <path>   Download a shared file")
+	fmt.Println("  wiki list [--raw] [--keyword=<text>] <projectKey>   List wiki pages")
+	fmt.Println("  wiki view [--raw] <wikiId|PROJ:PageName>   View a wiki page")
+	fmt.Println("  wiki create [--yes] --body-file=<path> [--tag=<tag>] [projectKey] <name>   Create a wiki page")
+	fmt.Println("  wiki edit [--yes] [--name=<name>] [--tag=<tag>] [--untag=<tag>] <wikiId|PROJ:PageName>   Edit a wiki page in your editor")
+	fmt.Println("  wiki delete [--yes] [--mail-notify] <wikiId|PROJ:PageName>   Delete a wiki page")
+	fmt.Println("  wiki history [--raw] <wikiId|PROJ:PageName>   List a wiki page's revisions")
+	fmt.Println("  wiki diff <wikiId|PROJ:PageName> <v1> <v2>   Show changes between two revisions")
+	fmt.Println("  wiki attach [--yes] <wikiId|PROJ:PageName> <file>...   Attach files to a wiki page")
+	fmt.Println("  wiki attachments [--raw] [--download [--dir <dir>]] <wikiId|PROJ:PageName>   List or download a wiki page's attachments")
+	fmt.Println("  wiki tags [--raw] <projectKey>   List wiki tags")
+	fmt.Println("  wiki search [--raw] <projectKey> <keyword>   Search wiki pages")
+	fmt.Println("  wiki export [--attachments] <projectKey> <dir>   Download all wiki pages as Markdown files")
+	fmt.Println("  wiki sync [--yes] [--force] [--dry-run] <dir>   Push local edits of an export back to the wiki")
+	fmt.Println("  repo list [--raw] <projectKey>   List Git repositories")
+	fmt.Println("  repo view [--raw] [--clone] <projectKey> <repo>   View or clone a Git repository")
+	fmt.Println("  pr list [--raw] [--status <status>] [--assignee <user>] <projectKey> <repo>   List pull requests")
+	fmt.Println("  pr view [--raw] [--web] <projectKey> <repo> <number>   View a pull request")
+	fmt.Println("  pr browse <projectKey> <repo> <number>   Open a pull request in the browser")
+	fmt.Println("  pr create [--yes] --base <branch> --title <title> [options] <projectKey> <repo>   Create a pull request")
+	fmt.Println("  pr edit [--yes] [options] <projectKey> <repo> <number>   Edit a pull request")
+	fmt.Println("  pr attachments [--raw] [--download [--dir <dir>]] <projectKey> <repo> <number>   List or download a pull request's attachments")
+	fmt.Println("  pr count [--raw] [--status <status>] [--assignee <user>] <projectKey> <repo>   Count pull requests")
+	fmt.Println("  pr checkout <number>    Check out a pull request's branch in the current clone")
+	fmt.Println("  pr status [--raw]       Show the open pull request from the current branch")
+	fmt.Println("  quick [--raw] [--yes] \"PROJ: summary !priority @user due:<date> #category\"   Create an issue from one line")
+	fmt.Println("  next [--raw] [-n <count>]   Show what to work on next")
+	fmt.Println("  ratelimit [--raw]       Show remaining API quotas and reset times")
+	fmt.Println("  queue list [--raw]      List comments queued while offline")
+	fmt.Println("  queue flush             Post comments queued while offline")
+	fmt.Println("  stats --self [--raw]    Show local usage counters")
+	fmt.Println("  bugreport [-o <path>] [-- <command>...]   Write a sanitized bug report file")
 	fmt.Println("  help                    Show this help message")
 	fmt.Println("  version                 Show version information")
 	fmt.Println()
 	fmt.Println("Options:")
-	fmt.Println("  -h, --help      Show this help message")
-	fmt.Println("  -v, --version   Show version information")
+	fmt.Println("  --render=<mode>   Render Markdown output: auto (default, only on a terminal), always, or never")
+	fmt.Println("  --require-space=<host>   Fail unless the configured space is <host>")
+	fmt.Println("  -h, --help        Show this help message")
+	fmt.Println("  -v, --version     Show version information")
 	fmt.Println()
 	fmt.Printf("Version: %s (commit: %s, built: %s)\n", version, commit, date)
 }
 
+func handleInit() {
+	if len(os.Args) > 2 {
+		switch os.Args[2] {
+		case "-h", "--help", "help":
+			printInitUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", os.Args[2])
+			printInitUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := setup.Init(); err != nil {
+		fail(err)
+	}
+}
+
+func printInitUsage() {
+	fmt.Println("Usage: bgl init")
+	fmt.Println()
+	fmt.Println("Guides you through login, a connectivity check, and choosing a")
+	fmt.Println("default project and editor/pager preferences.")
+}
+
+// defaultProject returns the default project chosen in 'bgl init', if any.
+func defaultProject() string {
+	cfg, err := config.Load()
+	if err != nil {
+		return ""
+	}
+	return cfg.DefaultProject
+}
+
 func handleAuth() {
 	if len(os.Args) < 3 {
 		printAuthUsage()
@@ -95,15 +416,13 @@ func handleAuth() {
 
 	switch os.Args[2] {
 	case "login":
-		if err := auth.Login(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
+		handleAuthLogin()
 	case "logout":
 		if err := auth.Logout(); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+			fail(err)
 		}
+	case "status":
+		handleAuthStatus()
 	case "-h", "--help", "help":
 		printAuthUsage()
 	default:
@@ -117,8 +436,90 @@ func printAuthUsage() {
 	fmt.Println("Usage: bgl auth <command>")
 	fmt.Println()
 	fmt.Println("Commands:")
-	fmt.Println("  login     Login to Backlog using OAuth 2.0")
-	fmt.Println("  logout    Logout and remove stored tokens")
+	fmt.Println("  login     Login to Backlog using OAuth 2.0 or an API key")
+	fmt.Println("  logout    Logout and remove stored credentials")
+	fmt.Println("  status    Check that the stored login works")
+}
+
+func handleAuthLogin() {
+	// Parse arguments: bgl auth login [--with-api-key]
+	args := os.Args[3:]
+
+	withAPIKey := false
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--with-api-key":
+			withAPIKey = true
+		case "-h", "--help":
+			printAuthLoginUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printAuthLoginUsage()
+			os.Exit(1)
+		}
+	}
+
+	login := auth.Login
+	if withAPIKey {
+		login = auth.LoginWithAPIKey
+	}
+	if err := login(); err != nil {
+		fail(err)
+	}
+}
+
+func printAuthLoginUsage() {
+	fmt.Println("Usage: bgl auth login [options]")
+	fmt.Println()
+	fmt.Println("Logs in to a Backlog space. By default this runs the OAuth 2.0 flow in")
+	fmt.Println("the browser. With --with-api-key, prompts for a personal API key instead,")
+	fmt.Println("which is sent as the apiKey query parameter on every request.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --with-api-key  Authenticate with an API key instead of OAuth")
+	fmt.Println("  -h, --help      Show this help message")
+}
+
+func handleAuthStatus() {
+	// Parse arguments: bgl auth status [--raw]
+	args := os.Args[3:]
+
+	opts := setup.StatusOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printAuthStatusUsage()
+			return
+		case "--all":
+			fmt.Fprintln(os.Stderr, "Error: --all is not supported: bgl stores a single login, which auth status always checks")
+			os.Exit(1)
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printAuthStatusUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := setup.Status(opts); err != nil {
+		fail(err)
+	}
+}
+
+func printAuthStatusUsage() {
+	fmt.Println("Usage: bgl auth status [options]")
+	fmt.Println()
+	fmt.Println("Checks the stored login by calling the API, refreshing an expired")
+	fmt.Println("access token when possible. Exits non-zero if the login is not usable.")
+	fmt.Println("bgl stores a single login, so only the current space is checked.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output the status as JSON")
+	fmt.Println("  -h, --help  Show this help message")
 }
 
 func handleIssue() {
@@ -130,10 +531,20 @@ func handleIssue() {
 	switch os.Args[2] {
 	case "view":
 		handleIssueView()
+	case "list":
+		handleIssueList()
 	case "add":
 		handleIssueAdd()
 	case "update":
 		handleIssueUpdate()
+	case "prs":
+		handleIssuePullRequests()
+	case "history":
+		handleIssueHistory()
+	case "reopen":
+		handleIssueReopen()
+	case "resolution":
+		handleIssueResolution()
 	case "-h", "--help", "help":
 		printIssueUsage()
 	default:
@@ -180,8 +591,7 @@ func handleIssueView() {
 	}
 
 	if err := issue.View(issueKey, opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		fail(err)
 	}
 }
 
@@ -190,8 +600,91 @@ func printIssueUsage() {
 	fmt.Println()
 	fmt.Println("Commands:")
 	fmt.Println("  view [--raw] <issueKey>   View an issue by key or ID")
+	fmt.Println("  list [--raw] [--tsv] [--project=<projectIdOrKey>]   List issues")
 	fmt.Println("  add [--raw] [--yes] --project=<projectIdOrKey> [options]   Create a new issue")
 	fmt.Println("  update [--raw] [options] <issueKey>   Update an issue")
+	fmt.Println("  prs [--raw] <issueKey>   List pull requests linked to an issue")
+	fmt.Println("  history [--raw] [--field=<field> [--diff]] <issueKey>   Show an issue's change history")
+	fmt.Println("  reopen [--raw] [--comment=<text>] <issueKey>   Reopen a closed issue")
+	fmt.Println("  resolution [--raw] <issueKey> <resolution>   Set an issue's resolution")
+}
+
+func handleIssueList() {
+	// Parse arguments: bgl issue list [--raw] [--tsv] [--format=jsonl] [--project=<projectIdOrKey>] [--count=<n>]
+	args := os.Args[3:]
+
+	opts := issue.ListOptions{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--tsv":
+			opts.TSV = true
+		case arg == "-h" || arg == "--help":
+			printIssueListUsage()
+			return
+		case arg == "--format":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printIssueListUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Format = args[i]
+		case strings.HasPrefix(arg, "--format="):
+			opts.Format = strings.TrimPrefix(arg, "--format=")
+		case strings.HasPrefix(arg, "--project="):
+			opts.ProjectIDOrKey = strings.TrimPrefix(arg, "--project=")
+		case strings.HasPrefix(arg, "--count="):
+			count, err := strconv.Atoi(strings.TrimPrefix(arg, "--count="))
+			if err != nil || count < 1 {
+				fmt.Fprintln(os.Stderr, "Error: --count must be a positive number")
+				printIssueListUsage()
+				os.Exit(1)
+			}
+			opts.Count = count
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+			printIssueListUsage()
+			os.Exit(1)
+		}
+	}
+
+	if opts.Format != "" && opts.Format != "jsonl" {
+		fmt.Fprintf(os.Stderr, "Error: unsupported format: %s\n", opts.Format)
+		printIssueListUsage()
+		os.Exit(1)
+	}
+	// JSON Lines output pages through all issues, so only it may exceed one page
+	if opts.Count > 100 && opts.Format != "jsonl" {
+		fmt.Fprintln(os.Stderr, "Error: --count must be a number between 1 and 100")
+		printIssueListUsage()
+		os.Exit(1)
+	}
+
+	if opts.ProjectIDOrKey == "" {
+		opts.ProjectIDOrKey = defaultProject()
+	}
+
+	if err := issue.List(opts); err != nil {
+		fail(err)
+	}
+}
+
+func printIssueListUsage() {
+	fmt.Println("Usage: bgl issue list [options]")
+	fmt.Println()
+	fmt.Println("Lists issues, most recently updated first.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --project=<idOrKey>   Project ID or key (default: the default project, or all projects)")
+	fmt.Println("  --count=<n>           Number of issues to list, 1-100 (default: 20; with --format=jsonl, any number, default: all)")
+	fmt.Println("  --tsv                 Output tab-separated values: key, id, summary, status, assignee, due, updated")
+	fmt.Println("  --format=jsonl        Output one JSON object per line")
+	fmt.Println("  --raw                 Output raw JSON response")
+	fmt.Println("  -h, --help            Show this help message")
 }
 
 func handleIssueAdd() {
@@ -241,6 +734,10 @@ func handleIssueAdd() {
 		}
 	}
 
+	if opts.ProjectIDOrKey == "" {
+		opts.ProjectIDOrKey = defaultProject()
+	}
+
 	if opts.ProjectIDOrKey == "" {
 		fmt.Fprintln(os.Stderr, "Error: --project is required")
 		printIssueAddUsage()
@@ -248,8 +745,7 @@ func handleIssueAdd() {
 	}
 
 	if err := issue.Add(opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		fail(err)
 	}
 }
 
@@ -257,7 +753,7 @@ func printIssueAddUsage() {
 	fmt.Println("Usage: bgl issue add [options] --project=<projectIdOrKey>")
 	fmt.Println()
 	fmt.Println("Options:")
-	fmt.Println("  --project=<idOrKey>     Project ID or key (required)")
+	fmt.Println("  --project=<idOrKey>     Project ID or key (required unless a default project is set)")
 	fmt.Println("  --summary=<text>        Issue summary (prompted if omitted)")
 	fmt.Println("  --type=<id>             Issue type ID (prompted if omitted)")
 	fmt.Println("  --priority=<id>         Priority ID (prompted if omitted)")
@@ -329,6 +825,10 @@ func handleIssueUpdate() {
 			opts.VersionIDs = strings.TrimPrefix(arg, "--version=")
 		case strings.HasPrefix(arg, "--comment="):
 			opts.Comment = strings.TrimPrefix(arg, "--comment=")
+		case strings.HasPrefix(arg, "--notify="):
+			opts.Notify = strings.TrimPrefix(arg, "--notify=")
+		case strings.HasPrefix(arg, "--visibility="):
+			opts.Scope = strings.TrimPrefix(arg, "--visibility=")
 		default:
 			if issueKey == "" {
 				issueKey = arg
@@ -347,8 +847,7 @@ func handleIssueUpdate() {
 	}
 
 	if err := issue.Update(issueKey, opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		fail(err)
 	}
 }
 
@@ -371,58 +870,37 @@ func printIssueUpdateUsage() {
 	fmt.Println("  --milestone=<id,...>    Milestone IDs (comma-separated)")
 	fmt.Println("  --version=<id,...>      Version IDs (comma-separated)")
 	fmt.Println("  --comment=<text>        Comment to add with the update")
+	fmt.Println("  --notify=<user,...>     Users to notify (ID, user ID, name, or mail), or teams with --visibility=team")
+	fmt.Println("  --visibility=<scope>    Who to notify: users (default), team, watchers, or none")
 	fmt.Println("  --raw                   Output raw JSON response")
 	fmt.Println("  -h, --help              Show this help message")
 }
 
-func handleComment() {
-	if len(os.Args) < 3 {
-		printCommentUsage()
-		os.Exit(1)
-	}
-
-	switch os.Args[2] {
-	case "view":
-		handleCommentView()
-	case "add":
-		handleCommentAdd()
-	case "-h", "--help", "help":
-		printCommentUsage()
-	default:
-		fmt.Fprintf(os.Stderr, "Unknown comment command: %s\n", os.Args[2])
-		printCommentUsage()
-		os.Exit(1)
-	}
-}
-
-func handleCommentView() {
-	// Parse arguments: bgl comment view [--raw] <issueKey> [commentId]
+func handleIssuePullRequests() {
+	// Parse arguments: bgl issue prs [--raw] <issueKey>
 	args := os.Args[3:]
 	if len(args) == 0 {
 		fmt.Fprintln(os.Stderr, "Error: issue key is required")
-		printCommentViewUsage()
+		printIssuePullRequestsUsage()
 		os.Exit(1)
 	}
 
-	opts := comment.ViewOptions{}
+	opts := issue.PullRequestsOptions{}
 	var issueKey string
-	var commentID string
 
 	for i := 0; i < len(args); i++ {
 		switch args[i] {
 		case "--raw":
 			opts.Raw = true
 		case "-h", "--help":
-			printCommentViewUsage()
+			printIssuePullRequestsUsage()
 			return
 		default:
 			if issueKey == "" {
 				issueKey = args[i]
-			} else if commentID == "" {
-				commentID = args[i]
 			} else {
 				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
-				printCommentViewUsage()
+				printIssuePullRequestsUsage()
 				os.Exit(1)
 			}
 		}
@@ -430,63 +908,59 @@ func handleCommentView() {
 
 	if issueKey == "" {
 		fmt.Fprintln(os.Stderr, "Error: issue key is required")
-		printCommentViewUsage()
+		printIssuePullRequestsUsage()
 		os.Exit(1)
 	}
 
-	var err error
-	if commentID != "" {
-		// View single comment
-		err = comment.View(issueKey, commentID, opts)
-	} else {
-		// View comment list
-		err = comment.ViewList(issueKey, opts)
-	}
-
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+	if err := issue.PullRequests(issueKey, opts); err != nil {
+		fail(err)
 	}
 }
 
-func printCommentUsage() {
-	fmt.Println("Usage: bgl comment <command>")
+func printIssuePullRequestsUsage() {
+	fmt.Println("Usage: bgl issue prs [options] <issueKey>")
 	fmt.Println()
-	fmt.Println("Commands:")
-	fmt.Println("  view [--raw] <issueKey> [commentId]   View comments for an issue")
-	fmt.Println("  add [--raw] [--yes] <issueKey> [message]   Add a comment to an issue")
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
 }
 
-func handleCommentAdd() {
-	// Parse arguments: bgl comment add [--raw] [--yes] <issueKey> [message]
+func handleIssueHistory() {
+	// Parse arguments: bgl issue history [--raw] [--field=<field>] [--diff] <issueKey>
 	args := os.Args[3:]
-	if len(args) == 0 {
-		fmt.Fprintln(os.Stderr, "Error: issue key is required")
-		printCommentAddUsage()
-		os.Exit(1)
-	}
 
-	opts := comment.AddOptions{}
+	opts := issue.HistoryOptions{}
 	var issueKey string
-	var message string
 
 	for i := 0; i < len(args); i++ {
-		switch args[i] {
-		case "--raw":
+		arg := args[i]
+		switch {
+		case arg == "--raw":
 			opts.Raw = true
-		case "--yes", "-y":
-			opts.Yes = true
-		case "-h", "--help":
-			printCommentAddUsage()
+		case arg == "--diff":
+			opts.Diff = true
+		case arg == "-h" || arg == "--help":
+			printIssueHistoryUsage()
 			return
+		case arg == "--field":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printIssueHistoryUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Field = args[i]
+		case strings.HasPrefix(arg, "--field="):
+			opts.Field = strings.TrimPrefix(arg, "--field=")
 		default:
 			if issueKey == "" {
-				issueKey = args[i]
-			} else if message == "" {
-				message = args[i]
+				issueKey = arg
 			} else {
-				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
-				printCommentAddUsage()
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printIssueHistoryUsage()
 				os.Exit(1)
 			}
 		}
@@ -494,86 +968,72 @@ func handleCommentAdd() {
 
 	if issueKey == "" {
 		fmt.Fprintln(os.Stderr, "Error: issue key is required")
-		printCommentAddUsage()
+		printIssueHistoryUsage()
 		os.Exit(1)
 	}
 
-	if err := comment.Add(issueKey, message, opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	if opts.Diff && opts.Field == "" {
+		fmt.Fprintln(os.Stderr, "Error: --diff requires --field")
+		printIssueHistoryUsage()
 		os.Exit(1)
 	}
+
+	if err := issue.History(issueKey, opts); err != nil {
+		fail(err)
+	}
 }
 
-func printCommentAddUsage() {
-	fmt.Println("Usage: bgl comment add [options] <issueKey> [message]")
+func printIssueHistoryUsage() {
+	fmt.Println("Usage: bgl issue history [options] <issueKey>")
 	fmt.Println()
 	fmt.Println("Arguments:")
-	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
-	fmt.Println("  message     The comment message (optional, will prompt if omitted)")
+	fmt.Println("  issueKey         The issue key (e.g., PROJECT-123) or issue ID")
 	fmt.Println()
 	fmt.Println("Options:")
-	fmt.Println("  --raw       Output raw JSON response")
-	fmt.Println("  --yes, -y   Skip confirmation prompt")
-	fmt.Println("  -h, --help  Show this help message")
+	fmt.Println("  --field=<field>  Only show changes of this field (e.g., description, status)")
+	fmt.Println("  --diff           Show each change of --field as a unified diff")
+	fmt.Println("  --raw            Output the change entries as JSON")
+	fmt.Println("  -h, --help       Show this help message")
 }
 
-func printCommentViewUsage() {
-	fmt.Println("Usage: bgl comment view [options] <issueKey> [commentId]")
-	fmt.Println()
-	fmt.Println("Arguments:")
-	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
-	fmt.Println("  commentId   The comment ID (optional, if omitted shows all comments)")
-	fmt.Println()
-	fmt.Println("Options:")
-	fmt.Println("  --raw       Output raw JSON response")
-	fmt.Println("  -h, --help  Show this help message")
-}
-
-func handleAttachment() {
-	if len(os.Args) < 3 {
-		printAttachmentUsage()
-		os.Exit(1)
-	}
-
-	switch os.Args[2] {
-	case "list":
-		handleAttachmentList()
-	case "download":
-		handleAttachmentDownload()
-	case "-h", "--help", "help":
-		printAttachmentUsage()
-	default:
-		fmt.Fprintf(os.Stderr, "Unknown attachment command: %s\n", os.Args[2])
-		printAttachmentUsage()
-		os.Exit(1)
-	}
-}
-
-func handleAttachmentList() {
-	// Parse arguments: bgl attachment list [--raw] <issueKey>
+func handleIssueReopen() {
+	// Parse arguments: bgl issue reopen [--raw] [--comment=<text>] <issueKey>
 	args := os.Args[3:]
 	if len(args) == 0 {
 		fmt.Fprintln(os.Stderr, "Error: issue key is required")
-		printAttachmentListUsage()
+		printIssueReopenUsage()
 		os.Exit(1)
 	}
 
-	opts := attachment.ListOptions{}
+	opts := issue.ReopenOptions{}
 	var issueKey string
 
 	for i := 0; i < len(args); i++ {
-		switch args[i] {
-		case "--raw":
+		arg := args[i]
+		switch {
+		case arg == "--raw":
 			opts.Raw = true
-		case "-h", "--help":
-			printAttachmentListUsage()
+		case arg == "-h" || arg == "--help":
+			printIssueReopenUsage()
 			return
+		case arg == "--comment":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printIssueReopenUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Comment = args[i]
+		case strings.HasPrefix(arg, "--comment="):
+			opts.Comment = strings.TrimPrefix(arg, "--comment=")
+		case strings.HasPrefix(arg, "--notify="):
+			opts.Notify = strings.TrimPrefix(arg, "--notify=")
 		default:
 			if issueKey == "" {
-				issueKey = args[i]
+				issueKey = arg
 			} else {
-				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
-				printAttachmentListUsage()
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printIssueReopenUsage()
 				os.Exit(1)
 			}
 		}
@@ -581,409 +1041,6474 @@ func handleAttachmentList() {
 
 	if issueKey == "" {
 		fmt.Fprintln(os.Stderr, "Error: issue key is required")
-		printAttachmentListUsage()
+		printIssueReopenUsage()
 		os.Exit(1)
 	}
 
-	if err := attachment.List(issueKey, opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+	if err := issue.Reopen(issueKey, opts); err != nil {
+		fail(err)
 	}
 }
 
-func handleAttachmentDownload() {
-	// Parse arguments: bgl attachment download [-o <path>] <issueKey> <attachmentId>
+func printIssueReopenUsage() {
+	fmt.Println("Usage: bgl issue reopen [options] <issueKey>")
+	fmt.Println()
+	fmt.Println("Moves a resolved or closed issue back to the project's initial status.")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey           The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --comment=<text>      Comment to add with the status change")
+	fmt.Println("  --notify=<user,...>   Users to notify (ID, user ID, name, or mail)")
+	fmt.Println("  --raw                 Output raw JSON response")
+	fmt.Println("  -h, --help            Show this help message")
+}
+
+func handleIssueResolution() {
+	// Parse arguments: bgl issue resolution [--raw] <issueKey> <resolution>
 	args := os.Args[3:]
 	if len(args) == 0 {
-		fmt.Fprintln(os.Stderr, "Error: issue key and attachment ID are required")
-		printAttachmentDownloadUsage()
+		fmt.Fprintln(os.Stderr, "Error: issue key and resolution are required")
+		printIssueResolutionUsage()
 		os.Exit(1)
 	}
 
-	opts := attachment.DownloadOptions{}
+	opts := issue.ResolutionOptions{}
 	var issueKey string
-	var attachmentID string
+	var resolution string
 
 	for i := 0; i < len(args); i++ {
 		arg := args[i]
 		switch {
-		case arg == "-o" || arg == "--output":
-			if i+1 >= len(args) {
-				fmt.Fprintf(os.Stderr, "Error: %s requires a path\n", arg)
-				printAttachmentDownloadUsage()
-				os.Exit(1)
-			}
-			i++
-			opts.Output = args[i]
-		case strings.HasPrefix(arg, "--output="):
-			opts.Output = strings.TrimPrefix(arg, "--output=")
+		case arg == "--raw":
+			opts.Raw = true
 		case arg == "-h" || arg == "--help":
-			printAttachmentDownloadUsage()
+			printIssueResolutionUsage()
 			return
+		case strings.HasPrefix(arg, "--notify="):
+			opts.Notify = strings.TrimPrefix(arg, "--notify=")
 		default:
 			if issueKey == "" {
 				issueKey = arg
-			} else if attachmentID == "" {
-				attachmentID = arg
+			} else if resolution == "" {
+				resolution = arg
 			} else {
 				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
-				printAttachmentDownloadUsage()
+				printIssueResolutionUsage()
 				os.Exit(1)
 			}
 		}
 	}
 
-	if issueKey == "" || attachmentID == "" {
-		fmt.Fprintln(os.Stderr, "Error: issue key and attachment ID are required")
-		printAttachmentDownloadUsage()
+	if issueKey == "" || resolution == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key and resolution are required")
+		printIssueResolutionUsage()
 		os.Exit(1)
 	}
 
-	if err := attachment.Download(issueKey, attachmentID, opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+	if err := issue.SetResolution(issueKey, resolution, opts); err != nil {
+		fail(err)
 	}
 }
 
-func printAttachmentUsage() {
-	fmt.Println("Usage: bgl attachment <command>")
-	fmt.Println()
-	fmt.Println("Commands:")
-	fmt.Println("  list [--raw] <issueKey>   List attachments for an issue")
-	fmt.Println("  download [-o <path>] <issueKey> <attachmentId>   Download an issue's attachment")
-}
-
-func printAttachmentListUsage() {
-	fmt.Println("Usage: bgl attachment list [options] <issueKey>")
-	fmt.Println()
-	fmt.Println("Arguments:")
-	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
-	fmt.Println()
-	fmt.Println("Options:")
-	fmt.Println("  --raw       Output raw JSON response")
-	fmt.Println("  -h, --help  Show this help message")
-}
-
-func printAttachmentDownloadUsage() {
-	fmt.Println("Usage: bgl attachment download [options] <issueKey> <attachmentId>")
+func printIssueResolutionUsage() {
+	fmt.Println("Usage: bgl issue resolution [options] <issueKey> <resolution>")
 	fmt.Println()
 	fmt.Println("Arguments:")
-	fmt.Println("  issueKey        The issue key (e.g., PROJECT-123) or issue ID")
-	fmt.Println("  attachmentId    The attachment ID (see 'bgl attachment list')")
+	fmt.Println("  issueKey     The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println("  resolution   The resolution name (e.g., \"Won't Fix\") or ID")
 	fmt.Println()
 	fmt.Println("Options:")
-	fmt.Println("  -o, --output=<path>   Save the file to the given path (default: original filename)")
+	fmt.Println("  --notify=<user,...>   Users to notify (ID, user ID, name, or mail)")
+	fmt.Println("  --raw                 Output raw JSON response")
 	fmt.Println("  -h, --help            Show this help message")
 }
 
-func handleStatus() {
+func handleComment() {
 	if len(os.Args) < 3 {
-		printStatusUsage()
+		printCommentUsage()
 		os.Exit(1)
 	}
 
 	switch os.Args[2] {
-	case "list":
-		handleStatusList()
+	case "view":
+		handleCommentView()
+	case "add":
+		handleCommentAdd()
+	case "edit":
+		handleCommentEdit()
+	case "delete":
+		handleCommentDelete()
+	case "latest":
+		handleCommentLatest()
+	case "notifications":
+		handleCommentNotifications()
+	case "count":
+		handleCommentCount()
+	case "url":
+		handleCommentURL()
+	case "reply":
+		handleCommentReply()
+	case "search":
+		handleCommentSearch()
 	case "-h", "--help", "help":
-		printStatusUsage()
+		printCommentUsage()
 	default:
-		fmt.Fprintf(os.Stderr, "Unknown status command: %s\n", os.Args[2])
-		printStatusUsage()
+		fmt.Fprintf(os.Stderr, "Unknown comment command: %s\n", os.Args[2])
+		printCommentUsage()
 		os.Exit(1)
 	}
 }
 
-func handleStatusList() {
-	// Parse arguments: bgl status list [--raw] <projectId>
+func handleCommentView() {
+	// Parse arguments: bgl comment view [--raw] <issueKey> [commentId]
 	args := os.Args[3:]
 	if len(args) == 0 {
-		fmt.Fprintln(os.Stderr, "Error: project ID is required")
-		printStatusListUsage()
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printCommentViewUsage()
 		os.Exit(1)
 	}
 
-	opts := status.ListOptions{}
-	var projectID string
+	opts := comment.ViewOptions{}
+	var issueKey string
+	var commentID string
 
 	for i := 0; i < len(args); i++ {
-		switch args[i] {
-		case "--raw":
+		arg := args[i]
+		switch {
+		case arg == "--raw":
 			opts.Raw = true
-		case "-h", "--help":
-			printStatusListUsage()
+		case arg == "-h" || arg == "--help":
+			printCommentViewUsage()
 			return
+		case arg == "--all":
+			opts.All = true
+		case strings.HasPrefix(arg, "--count="):
+			count, err := strconv.Atoi(strings.TrimPrefix(arg, "--count="))
+			if err != nil || count < 1 || count > 100 {
+				fmt.Fprintln(os.Stderr, "Error: --count must be a number between 1 and 100")
+				printCommentViewUsage()
+				os.Exit(1)
+			}
+			opts.Count = count
+		case strings.HasPrefix(arg, "--order="):
+			opts.Order = strings.TrimPrefix(arg, "--order=")
+			if opts.Order != "asc" && opts.Order != "desc" {
+				fmt.Fprintln(os.Stderr, "Error: --order must be asc or desc")
+				printCommentViewUsage()
+				os.Exit(1)
+			}
+		case strings.HasPrefix(arg, "--since="):
+			opts.Since = strings.TrimPrefix(arg, "--since=")
+		case strings.HasPrefix(arg, "--until="):
+			opts.Until = strings.TrimPrefix(arg, "--until=")
+		case strings.HasPrefix(arg, "--period="):
+			opts.Period = strings.TrimPrefix(arg, "--period=")
+		case arg == "--author":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printCommentViewUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Author = args[i]
+		case strings.HasPrefix(arg, "--author="):
+			opts.Author = strings.TrimPrefix(arg, "--author=")
+		case arg == "--format":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printCommentViewUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Format = args[i]
+		case strings.HasPrefix(arg, "--format="):
+			opts.Format = strings.TrimPrefix(arg, "--format=")
 		default:
-			if projectID == "" {
-				projectID = args[i]
+			if issueKey == "" {
+				issueKey = arg
+			} else if commentID == "" {
+				commentID = arg
 			} else {
-				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
-				printStatusListUsage()
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printCommentViewUsage()
 				os.Exit(1)
 			}
 		}
 	}
 
-	if projectID == "" {
-		fmt.Fprintln(os.Stderr, "Error: project ID is required")
-		printStatusListUsage()
+	if opts.Format != "" && opts.Format != "jsonl" {
+		fmt.Fprintf(os.Stderr, "Error: unsupported format: %s\n", opts.Format)
+		printCommentViewUsage()
 		os.Exit(1)
 	}
 
-	if err := status.List(projectID, opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	if issueKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printCommentViewUsage()
 		os.Exit(1)
 	}
-}
-
-func printStatusUsage() {
-	fmt.Println("Usage: bgl status <command>")
-	fmt.Println()
-	fmt.Println("Commands:")
-	fmt.Println("  list [--raw] <projectId>   List statuses for a project")
-}
-
-func printStatusListUsage() {
-	fmt.Println("Usage: bgl status list [options] <projectId>")
-	fmt.Println()
-	fmt.Println("Arguments:")
-	fmt.Println("  projectId   The project ID or project key")
-	fmt.Println()
-	fmt.Println("Options:")
-	fmt.Println("  --raw       Output raw JSON response")
-	fmt.Println("  -h, --help  Show this help message")
-}
 
-func handleCategory() {
-	if len(os.Args) < 3 {
-		printCategoryUsage()
-		os.Exit(1)
+	var err error
+	if commentID != "" {
+		// View single comment
+		err = comment.View(issueKey, commentID, opts)
+	} else {
+		// View comment list
+		err = comment.ViewList(issueKey, opts)
 	}
 
-	switch os.Args[2] {
-	case "list":
-		handleCategoryList()
-	case "-h", "--help", "help":
-		printCategoryUsage()
-	default:
-		fmt.Fprintf(os.Stderr, "Unknown category command: %s\n", os.Args[2])
-		printCategoryUsage()
-		os.Exit(1)
+	if err != nil {
+		fail(err)
 	}
 }
 
-func handleCategoryList() {
-	// Parse arguments: bgl category list [--raw] <projectId>
+func handleCommentLatest() {
+	// Parse arguments: bgl comment latest [--raw] [-n <count>] <issueKey>
 	args := os.Args[3:]
 	if len(args) == 0 {
-		fmt.Fprintln(os.Stderr, "Error: project ID is required")
-		printCategoryListUsage()
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printCommentLatestUsage()
 		os.Exit(1)
 	}
 
-	opts := category.ListOptions{}
-	var projectID string
+	opts := comment.ViewOptions{Count: 1, Order: "desc"}
+	var issueKey string
 
 	for i := 0; i < len(args); i++ {
-		switch args[i] {
-		case "--raw":
+		arg := args[i]
+		switch {
+		case arg == "--raw":
 			opts.Raw = true
-		case "-h", "--help":
-			printCategoryListUsage()
+		case arg == "-h" || arg == "--help":
+			printCommentLatestUsage()
 			return
+		case arg == "-n" || strings.HasPrefix(arg, "--count="):
+			value := strings.TrimPrefix(arg, "--count=")
+			if arg == "-n" {
+				if i+1 >= len(args) {
+					fmt.Fprintf(os.Stderr, "Error: %s requires a number\n", arg)
+					printCommentLatestUsage()
+					os.Exit(1)
+				}
+				i++
+				value = args[i]
+			}
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 || count > 100 {
+				fmt.Fprintln(os.Stderr, "Error: count must be a number between 1 and 100")
+				printCommentLatestUsage()
+				os.Exit(1)
+			}
+			opts.Count = count
 		default:
-			if projectID == "" {
-				projectID = args[i]
+			if issueKey == "" {
+				issueKey = arg
 			} else {
-				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
-				printCategoryListUsage()
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printCommentLatestUsage()
 				os.Exit(1)
 			}
 		}
 	}
 
-	if projectID == "" {
-		fmt.Fprintln(os.Stderr, "Error: project ID is required")
-		printCategoryListUsage()
+	if issueKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printCommentLatestUsage()
 		os.Exit(1)
 	}
 
-	if err := category.List(projectID, opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+	if err := comment.ViewList(issueKey, opts); err != nil {
+		fail(err)
 	}
 }
 
-func printCategoryUsage() {
-	fmt.Println("Usage: bgl category <command>")
-	fmt.Println()
-	fmt.Println("Commands:")
-	fmt.Println("  list [--raw] <projectId>   List categories for a project")
+func handleCommentSearch() {
+	// Parse arguments: bgl comment search [--raw] <issueKey> <query>
+	args := os.Args[3:]
+
+	opts := comment.SearchOptions{}
+	var issueKey string
+	var query string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printCommentSearchUsage()
+			return
+		default:
+			if issueKey == "" {
+				issueKey = args[i]
+			} else if query == "" {
+				query = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printCommentSearchUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if issueKey == "" || query == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key and query are required")
+		printCommentSearchUsage()
+		os.Exit(1)
+	}
+
+	if err := comment.Search(issueKey, query, opts); err != nil {
+		fail(err)
+	}
 }
 
-func printCategoryListUsage() {
-	fmt.Println("Usage: bgl category list [options] <projectId>")
+func printCommentSearchUsage() {
+	fmt.Println("Usage: bgl comment search [options] <issueKey> <query>")
+	fmt.Println()
+	fmt.Println("Searches all comments on an issue (case-insensitive) and shows the")
+	fmt.Println("matching comments with their IDs and the hits highlighted.")
 	fmt.Println()
 	fmt.Println("Arguments:")
-	fmt.Println("  projectId   The project ID or project key")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println("  query       The text to search for")
 	fmt.Println()
 	fmt.Println("Options:")
-	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  --raw       Output the matching comments as JSON")
 	fmt.Println("  -h, --help  Show this help message")
 }
 
-func handleMilestone() {
-	if len(os.Args) < 3 {
-		printMilestoneUsage()
-		os.Exit(1)
-	}
+func printCommentLatestUsage() {
+	fmt.Println("Usage: bgl comment latest [options] <issueKey>")
+	fmt.Println()
+	fmt.Println("Shows the most recent comments, newest first.")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey          The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -n, --count=<n>   Number of comments to show, 1-100 (default: 1)")
+	fmt.Println("  --raw             Output raw JSON response")
+	fmt.Println("  -h, --help        Show this help message")
+}
 
-	switch os.Args[2] {
-	case "list":
-		handleMilestoneList()
-	case "-h", "--help", "help":
-		printMilestoneUsage()
-	default:
-		fmt.Fprintf(os.Stderr, "Unknown milestone command: %s\n", os.Args[2])
-		printMilestoneUsage()
-		os.Exit(1)
-	}
+func printCommentUsage() {
+	fmt.Println("Usage: bgl comment <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  view [--raw] <issueKey> [commentId]   View comments for an issue")
+	fmt.Println("  latest [--raw] [-n <count>] <issueKey>   View the most recent comments")
+	fmt.Println("  search [--raw] <issueKey> <query>   Search an issue's comments")
+	fmt.Println("  add [--raw] [--yes] <issueKey> [message]   Add a comment to an issue")
+	fmt.Println("  reply [--raw] [--yes] <issueKey> <commentId>   Reply to a comment with a quote")
+	fmt.Println("  edit [--raw] [--yes] <issueKey> <commentId> [message]   Edit a comment")
+	fmt.Println("  delete [--raw] [--yes] <issueKey> <commentId>   Delete a comment")
+	fmt.Println("  notifications [--raw] <issueKey> <commentId>   Show who was notified about a comment")
+	fmt.Println("  count [--raw] <issueKey>   Print the number of comments on an issue")
+	fmt.Println("  url <issueKey> <commentId>   Print the link to a comment")
 }
 
-func handleMilestoneList() {
-	// Parse arguments: bgl milestone list [--raw] <projectId>
+func handleCommentAdd() {
+	// Parse arguments: bgl comment add [--raw] [--yes] <issueKey> [message]
 	args := os.Args[3:]
 	if len(args) == 0 {
-		fmt.Fprintln(os.Stderr, "Error: project ID is required")
-		printMilestoneListUsage()
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printCommentAddUsage()
 		os.Exit(1)
 	}
 
-	opts := milestone.ListOptions{}
-	var projectID string
+	opts := comment.AddOptions{}
+	var issueKey string
+	var message string
 
 	for i := 0; i < len(args); i++ {
-		switch args[i] {
-		case "--raw":
+		arg := args[i]
+		switch {
+		case arg == "--raw":
 			opts.Raw = true
-		case "-h", "--help":
-			printMilestoneListUsage()
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "--keep-html":
+			opts.KeepHTML = true
+		case arg == "--editor" || arg == "-e":
+			opts.Editor = true
+		case arg == "--body" || arg == "--body-file":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printCommentAddUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--body" && args[i] != "-" {
+				message = args[i]
+			} else {
+				opts.BodyFile = args[i]
+			}
+		case strings.HasPrefix(arg, "--body-file="):
+			opts.BodyFile = strings.TrimPrefix(arg, "--body-file=")
+		case arg == "-h" || arg == "--help":
+			printCommentAddUsage()
 			return
+		case arg == "--notify":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printCommentAddUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Notify = args[i]
+		case strings.HasPrefix(arg, "--notify="):
+			opts.Notify = strings.TrimPrefix(arg, "--notify=")
+		case arg == "--visibility":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printCommentAddUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Scope = args[i]
+		case strings.HasPrefix(arg, "--visibility="):
+			opts.Scope = strings.TrimPrefix(arg, "--visibility=")
 		default:
-			if projectID == "" {
-				projectID = args[i]
+			if issueKey == "" {
+				issueKey = arg
+			} else if message == "" {
+				message = arg
 			} else {
-				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
-				printMilestoneListUsage()
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printCommentAddUsage()
 				os.Exit(1)
 			}
 		}
 	}
 
-	if projectID == "" {
-		fmt.Fprintln(os.Stderr, "Error: project ID is required")
-		printMilestoneListUsage()
+	if issueKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printCommentAddUsage()
 		os.Exit(1)
 	}
 
-	if err := milestone.List(projectID, opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+	if err := comment.Add(issueKey, message, opts); err != nil {
+		fail(err)
 	}
 }
 
-func printMilestoneUsage() {
-	fmt.Println("Usage: bgl milestone <command>")
-	fmt.Println()
-	fmt.Println("Commands:")
-	fmt.Println("  list [--raw] <projectId>   List versions/milestones for a project")
-}
-
-func printMilestoneListUsage() {
-	fmt.Println("Usage: bgl milestone list [options] <projectId>")
+func printCommentAddUsage() {
+	fmt.Println("Usage: bgl comment add [options] <issueKey> [message]")
 	fmt.Println()
 	fmt.Println("Arguments:")
-	fmt.Println("  projectId   The project ID or project key")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println("  message     The comment message (optional, will prompt if omitted)")
 	fmt.Println()
 	fmt.Println("Options:")
-	fmt.Println("  --raw       Output raw JSON response")
-	fmt.Println("  -h, --help  Show this help message")
+	fmt.Println("  --editor, -e          Compose the message in your editor")
+	fmt.Println("  --body <text|->       The comment message, or - to read it from stdin")
+	fmt.Println("  --body-file=<path>    Read the comment message from a file (- for stdin)")
+	fmt.Println("  --notify=<user,...>   Users to notify (ID, user ID, name, or mail), or teams with --visibility=team")
+	fmt.Println("  --visibility=<scope>  Who to notify: users (default), team, watchers, or none")
+	fmt.Println("  --keep-html           Post HTML as-is instead of converting it to Markdown")
+	fmt.Println("  --raw                 Output raw JSON response")
+	fmt.Println("  --yes, -y             Skip confirmation prompt")
+	fmt.Println("  -h, --help            Show this help message")
 }
 
-func handleIssueType() {
-	if len(os.Args) < 3 {
-		printIssueTypeUsage()
-		os.Exit(1)
+func handleCommentReply() {
+	// Parse arguments: bgl comment reply [--raw] [--yes] [--notify=<users>] <issueKey> <commentId>
+	args := os.Args[3:]
+
+	opts := comment.ReplyOptions{}
+	var issueKey string
+	var commentID string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printCommentReplyUsage()
+			return
+		case arg == "--notify":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printCommentReplyUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Notify = args[i]
+		case strings.HasPrefix(arg, "--notify="):
+			opts.Notify = strings.TrimPrefix(arg, "--notify=")
+		case arg == "--visibility":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printCommentReplyUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Scope = args[i]
+		case strings.HasPrefix(arg, "--visibility="):
+			opts.Scope = strings.TrimPrefix(arg, "--visibility=")
+		default:
+			if issueKey == "" {
+				issueKey = arg
+			} else if commentID == "" {
+				commentID = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printCommentReplyUsage()
+				os.Exit(1)
+			}
+		}
 	}
 
-	switch os.Args[2] {
-	case "list":
-		handleIssueTypeList()
-	case "-h", "--help", "help":
-		printIssueTypeUsage()
-	default:
-		fmt.Fprintf(os.Stderr, "Unknown issuetype command: %s\n", os.Args[2])
-		printIssueTypeUsage()
+	if issueKey == "" || commentID == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key and comment ID are required")
+		printCommentReplyUsage()
 		os.Exit(1)
 	}
+
+	if err := comment.Reply(issueKey, commentID, opts); err != nil {
+		fail(err)
+	}
 }
 
-func handleIssueTypeList() {
-	// Parse arguments: bgl issuetype list [--raw] <projectId>
+func printCommentReplyUsage() {
+	fmt.Println("Usage: bgl comment reply [options] <issueKey> <commentId>")
+	fmt.Println()
+	fmt.Println("Opens your editor with the comment quoted and posts the result as a")
+	fmt.Println("new comment.")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println("  commentId   The comment ID to reply to")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --notify=<user,...>   Users to notify (ID, user ID, name, or mail), or teams with --visibility=team")
+	fmt.Println("  --visibility=<scope>  Who to notify: users (default), team, watchers, or none")
+	fmt.Println("  --raw                 Output raw JSON response")
+	fmt.Println("  --yes, -y             Skip confirmation prompt")
+	fmt.Println("  -h, --help            Show this help message")
+}
+
+func handleCommentEdit() {
+	// Parse arguments: bgl comment edit [--raw] [--yes] <issueKey> <commentId> [message]
 	args := os.Args[3:]
 	if len(args) == 0 {
-		fmt.Fprintln(os.Stderr, "Error: project ID is required")
-		printIssueTypeListUsage()
+		fmt.Fprintln(os.Stderr, "Error: issue key and comment ID are required")
+		printCommentEditUsage()
 		os.Exit(1)
 	}
 
-	opts := issuetype.ListOptions{}
-	var projectID string
+	opts := comment.EditOptions{}
+	var issueKey string
+	var commentID string
+	var message string
 
 	for i := 0; i < len(args); i++ {
 		switch args[i] {
 		case "--raw":
 			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
 		case "-h", "--help":
-			printIssueTypeListUsage()
+			printCommentEditUsage()
 			return
 		default:
-			if projectID == "" {
-				projectID = args[i]
+			if issueKey == "" {
+				issueKey = args[i]
+			} else if commentID == "" {
+				commentID = args[i]
+			} else if message == "" {
+				message = args[i]
 			} else {
 				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
-				printIssueTypeListUsage()
+				printCommentEditUsage()
 				os.Exit(1)
 			}
 		}
 	}
 
-	if projectID == "" {
-		fmt.Fprintln(os.Stderr, "Error: project ID is required")
-		printIssueTypeListUsage()
+	if issueKey == "" || commentID == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key and comment ID are required")
+		printCommentEditUsage()
 		os.Exit(1)
 	}
 
-	if err := issuetype.List(projectID, opts); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+	if err := comment.Edit(issueKey, commentID, message, opts); err != nil {
+		fail(err)
 	}
 }
 
-func printIssueTypeUsage() {
-	fmt.Println("Usage: bgl issuetype <command>")
-	fmt.Println()
-	fmt.Println("Commands:")
-	fmt.Println("  list [--raw] <projectId>   List issue types for a project")
-}
-
-func printIssueTypeListUsage() {
-	fmt.Println("Usage: bgl issuetype list [options] <projectId>")
+func printCommentEditUsage() {
+	fmt.Println("Usage: bgl comment edit [options] <issueKey> <commentId> [message]")
 	fmt.Println()
 	fmt.Println("Arguments:")
-	fmt.Println("  projectId   The project ID or project key")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println("  commentId   The comment ID")
+	fmt.Println("  message     The new comment message (optional, opens $EDITOR with the current content if omitted)")
 	fmt.Println()
 	fmt.Println("Options:")
 	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  --yes, -y   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleCommentDelete() {
+	// Parse arguments: bgl comment delete [--raw] [--yes] <issueKey> <commentId>
+	args := os.Args[3:]
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: issue key and comment ID are required")
+		printCommentDeleteUsage()
+		os.Exit(1)
+	}
+
+	opts := comment.DeleteOptions{}
+	var issueKey string
+	var commentID string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printCommentDeleteUsage()
+			return
+		default:
+			if issueKey == "" {
+				issueKey = args[i]
+			} else if commentID == "" {
+				commentID = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printCommentDeleteUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if issueKey == "" || commentID == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key and comment ID are required")
+		printCommentDeleteUsage()
+		os.Exit(1)
+	}
+
+	if err := comment.Delete(issueKey, commentID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printCommentDeleteUsage() {
+	fmt.Println("Usage: bgl comment delete [options] <issueKey> <commentId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println("  commentId   The comment ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  --yes, -y   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleCommentNotifications() {
+	// Parse arguments: bgl comment notifications [--raw] <issueKey> <commentId>
+	args := os.Args[3:]
+
+	opts := comment.NotificationsOptions{}
+	var issueKey string
+	var commentID string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printCommentNotificationsUsage()
+			return
+		default:
+			if issueKey == "" {
+				issueKey = args[i]
+			} else if commentID == "" {
+				commentID = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printCommentNotificationsUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if issueKey == "" || commentID == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key and comment ID are required")
+		printCommentNotificationsUsage()
+		os.Exit(1)
+	}
+
+	if err := comment.Notifications(issueKey, commentID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printCommentNotificationsUsage() {
+	fmt.Println("Usage: bgl comment notifications [options] <issueKey> <commentId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println("  commentId   The comment ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleCommentCount() {
+	// Parse arguments: bgl comment count [--raw] <issueKey>
+	args := os.Args[3:]
+
+	opts := comment.CountOptions{}
+	var issueKey string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printCommentCountUsage()
+			return
+		default:
+			if issueKey == "" {
+				issueKey = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printCommentCountUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if issueKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printCommentCountUsage()
+		os.Exit(1)
+	}
+
+	if err := comment.Count(issueKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printCommentCountUsage() {
+	fmt.Println("Usage: bgl comment count [options] <issueKey>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleCommentURL() {
+	// Parse arguments: bgl comment url <issueKey> <commentId>
+	args := os.Args[3:]
+
+	var issueKey string
+	var commentID string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "-h", "--help":
+			printCommentURLUsage()
+			return
+		default:
+			if issueKey == "" {
+				issueKey = args[i]
+			} else if commentID == "" {
+				commentID = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printCommentURLUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if issueKey == "" || commentID == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key and comment ID are required")
+		printCommentURLUsage()
+		os.Exit(1)
+	}
+
+	if err := comment.URL(issueKey, commentID); err != nil {
+		fail(err)
+	}
+}
+
+func printCommentURLUsage() {
+	fmt.Println("Usage: bgl comment url <issueKey> <commentId>")
+	fmt.Println()
+	fmt.Println("Prints the link to a comment without calling the API.")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123)")
+	fmt.Println("  commentId   The comment ID")
+}
+
+func printCommentViewUsage() {
+	fmt.Println("Usage: bgl comment view [options] <issueKey> [commentId]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println("  commentId   The comment ID (optional, if omitted shows all comments)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --all              Fetch all comments, following pages")
+	fmt.Println("  --count=<n>        Number of comments to fetch, 1-100 (default: 20; page size with --all,")
+	fmt.Println("                     or the most to show with --since, --until, --period, or --author)")
+	fmt.Println("  --order=asc|desc   Order by comment ID (default: desc)")
+	fmt.Println("  --since=<date>     Only comments posted on or after the date (yyyy-MM-dd)")
+	fmt.Println("  --until=<date>     Only comments posted on or before the date (yyyy-MM-dd)")
+	fmt.Println("  --period=<period>  Only comments posted in the period: this-week, last-week,")
+	fmt.Println("                     this-month, last-month, yyyy-Www, yyyy-Qn, ...")
+	fmt.Println("  --author=<user>    Only comments by the user (name, user ID, or mail)")
+	fmt.Println("  --raw              Output raw JSON response")
+	fmt.Println("  --format=jsonl     Output one JSON object per line")
+	fmt.Println("  -h, --help         Show this help message")
+}
+
+func handleAttachment() {
+	if len(os.Args) < 3 {
+		printAttachmentUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleAttachmentList()
+	case "add":
+		handleAttachmentAdd()
+	case "download":
+		handleAttachmentDownload()
+	case "download-all":
+		handleAttachmentDownloadAll()
+	case "-h", "--help", "help":
+		printAttachmentUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown attachment command: %s\n", os.Args[2])
+		printAttachmentUsage()
+		os.Exit(1)
+	}
+}
+
+func handleAttachmentList() {
+	// Parse arguments: bgl attachment list [--raw] <issueKey>
+	args := os.Args[3:]
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printAttachmentListUsage()
+		os.Exit(1)
+	}
+
+	opts := attachment.ListOptions{}
+	var issueKey string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printAttachmentListUsage()
+			return
+		default:
+			if issueKey == "" {
+				issueKey = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printAttachmentListUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if issueKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printAttachmentListUsage()
+		os.Exit(1)
+	}
+
+	if err := attachment.List(issueKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleAttachmentDownload() {
+	// Parse arguments: bgl attachment download [-o <path>] <issueKey> <attachmentId>
+	args := os.Args[3:]
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: issue key and attachment ID are required")
+		printAttachmentDownloadUsage()
+		os.Exit(1)
+	}
+
+	opts := attachment.DownloadOptions{}
+	var issueKey string
+	var attachmentID string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "-o" || arg == "--output":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a path\n", arg)
+				printAttachmentDownloadUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Output = args[i]
+		case strings.HasPrefix(arg, "--output="):
+			opts.Output = strings.TrimPrefix(arg, "--output=")
+		case arg == "-h" || arg == "--help":
+			printAttachmentDownloadUsage()
+			return
+		default:
+			if issueKey == "" {
+				issueKey = arg
+			} else if attachmentID == "" {
+				attachmentID = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printAttachmentDownloadUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if issueKey == "" || attachmentID == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key and attachment ID are required")
+		printAttachmentDownloadUsage()
+		os.Exit(1)
+	}
+
+	if err := attachment.Download(issueKey, attachmentID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleAttachmentDownloadAll() {
+	// Parse arguments: bgl attachment download-all [--dir <path>] <issueKey>
+	args := os.Args[3:]
+
+	opts := attachment.DownloadAllOptions{}
+	var issueKey string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--dir":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a path\n", arg)
+				printAttachmentDownloadAllUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Dir = args[i]
+		case strings.HasPrefix(arg, "--dir="):
+			opts.Dir = strings.TrimPrefix(arg, "--dir=")
+		case arg == "-h" || arg == "--help":
+			printAttachmentDownloadAllUsage()
+			return
+		default:
+			if issueKey == "" {
+				issueKey = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printAttachmentDownloadAllUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if issueKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printAttachmentDownloadAllUsage()
+		os.Exit(1)
+	}
+
+	if err := attachment.DownloadAll(issueKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printAttachmentUsage() {
+	fmt.Println("Usage: bgl attachment <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] <issueKey>   List attachments for an issue")
+	fmt.Println("  add [--raw] [--yes] <issueKey> <file>...   Attach files to an issue")
+	fmt.Println("  download [-o <path>] <issueKey> <attachmentId>   Download an issue's attachment")
+	fmt.Println("  download-all [--dir <path>] <issueKey>   Download all of an issue's attachments")
+}
+
+func handleAttachmentAdd() {
+	// Parse arguments: bgl attachment add [--raw] [--yes] <issueKey> <file>...
+	args := os.Args[3:]
+
+	opts := attachment.AddOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printAttachmentAddUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	if len(positional) < 2 {
+		fmt.Fprintln(os.Stderr, "Error: issue key and at least one file are required")
+		printAttachmentAddUsage()
+		os.Exit(1)
+	}
+
+	if err := attachment.Add(positional[0], positional[1:], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printAttachmentAddUsage() {
+	fmt.Println("Usage: bgl attachment add [options] <issueKey> <file>...")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println("  file        Files to attach")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -y, --yes   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printAttachmentListUsage() {
+	fmt.Println("Usage: bgl attachment list [options] <issueKey>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printAttachmentDownloadAllUsage() {
+	fmt.Println("Usage: bgl attachment download-all [options] <issueKey>")
+	fmt.Println()
+	fmt.Println("Downloads every attachment of the issue, including those added with")
+	fmt.Println("comments, and writes a manifest.json describing them.")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey    The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --dir <path>   Directory to save to (default: <issueKey>-files)")
+	fmt.Println("  -h, --help     Show this help message")
+}
+
+func printAttachmentDownloadUsage() {
+	fmt.Println("Usage: bgl attachment download [options] <issueKey> <attachmentId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey        The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println("  attachmentId    The attachment ID (see 'bgl attachment list')")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -o, --output=<path>   Save the file to the given path (default: original filename)")
+	fmt.Println("  -h, --help            Show this help message")
+}
+
+func handleStatus() {
+	if len(os.Args) < 3 {
+		printStatusUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleStatusList()
+	case "add":
+		handleStatusAdd()
+	case "edit":
+		handleStatusEdit()
+	case "rm":
+		handleStatusRemove()
+	case "order":
+		handleStatusOrder()
+	case "-h", "--help", "help":
+		printStatusUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown status command: %s\n", os.Args[2])
+		printStatusUsage()
+		os.Exit(1)
+	}
+}
+
+func handleStatusList() {
+	// Parse arguments: bgl status list [--raw] <projectId>
+	args := os.Args[3:]
+
+	opts := status.ListOptions{}
+	var projectID string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printStatusListUsage()
+			return
+		default:
+			if projectID == "" {
+				projectID = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printStatusListUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectID == "" {
+		projectID = defaultProject()
+	}
+
+	if projectID == "" {
+		fmt.Fprintln(os.Stderr, "Error: project ID is required")
+		printStatusListUsage()
+		os.Exit(1)
+	}
+
+	if err := status.List(projectID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printStatusUsage() {
+	fmt.Println("Usage: bgl status <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] <projectId>   List statuses for a project")
+	fmt.Println("  add [options] <projectId>  Add a status")
+	fmt.Println("  edit [options] <projectId> <status>")
+	fmt.Println("                             Rename or recolor a status")
+	fmt.Println("  rm [options] <projectId> <status>")
+	fmt.Println("                             Delete a status")
+	fmt.Println("  order [options] <projectId> <status>...")
+	fmt.Println("                             Reorder statuses")
+}
+
+func printStatusListUsage() {
+	fmt.Println("Usage: bgl status list [options] <projectId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleStatusAdd() {
+	// Parse arguments: bgl status add [--raw] [--yes] --name=<name> --color=<color> <projectId>
+	args := os.Args[3:]
+
+	opts := status.AddOptions{}
+	var projectID string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printStatusAddUsage()
+			return
+		case strings.HasPrefix(arg, "--name="):
+			opts.Name = strings.TrimPrefix(arg, "--name=")
+		case strings.HasPrefix(arg, "--color="):
+			opts.Color = strings.TrimPrefix(arg, "--color=")
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printStatusAddUsage()
+			os.Exit(1)
+		default:
+			if projectID == "" {
+				projectID = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printStatusAddUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectID == "" {
+		projectID = defaultProject()
+	}
+
+	if projectID == "" || opts.Name == "" || opts.Color == "" {
+		fmt.Fprintln(os.Stderr, "Error: project ID, --name and --color are required")
+		printStatusAddUsage()
+		os.Exit(1)
+	}
+
+	if err := status.Add(projectID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printStatusAddUsage() {
+	fmt.Println("Usage: bgl status add [options] <projectId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId        The project ID or project key (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --name=<name>    Status name (required)")
+	fmt.Println("  --color=<color>  Status color (required), one of:")
+	printStatusColors()
+	fmt.Println("  --raw            Output raw JSON response")
+	fmt.Println("  -y, --yes        Skip confirmation prompt")
+	fmt.Println("  -h, --help       Show this help message")
+}
+
+func printStatusColors() {
+	fmt.Println("                   #ea2c00 #e87758 #e07b9a #868cb7 #3b9dbd")
+	fmt.Println("                   #4caf93 #b0be3c #eda62a #f42858 #393939")
+}
+
+func handleStatusEdit() {
+	// Parse arguments: bgl status edit [--raw] [--yes] [--name=<name>] [--color=<color>] [projectId] <status>
+	args := os.Args[3:]
+
+	opts := status.EditOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printStatusEditUsage()
+			return
+		case strings.HasPrefix(arg, "--name="):
+			opts.Name = strings.TrimPrefix(arg, "--name=")
+		case strings.HasPrefix(arg, "--color="):
+			opts.Color = strings.TrimPrefix(arg, "--color=")
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printStatusEditUsage()
+			os.Exit(1)
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectID, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project ID and status are required")
+		printStatusEditUsage()
+		os.Exit(1)
+	}
+
+	if err := status.Edit(projectID, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printStatusEditUsage() {
+	fmt.Println("Usage: bgl status edit [options] [projectId] <status>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId        The project ID or project key (default: the default project)")
+	fmt.Println("  status           The status ID or name")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --name=<name>    New status name")
+	fmt.Println("  --color=<color>  New status color, one of:")
+	printStatusColors()
+	fmt.Println("  --raw            Output raw JSON response")
+	fmt.Println("  -y, --yes        Skip confirmation prompt")
+	fmt.Println("  -h, --help       Show this help message")
+}
+
+func handleStatusRemove() {
+	// Parse arguments: bgl status rm [--raw] [--yes] --substitute=<status> [projectId] <status>
+	args := os.Args[3:]
+
+	opts := status.RemoveOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printStatusRemoveUsage()
+			return
+		case strings.HasPrefix(arg, "--substitute="):
+			opts.Substitute = strings.TrimPrefix(arg, "--substitute=")
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printStatusRemoveUsage()
+			os.Exit(1)
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectID, rest, ok := projectArgs(positional, 1)
+	if !ok || opts.Substitute == "" {
+		fmt.Fprintln(os.Stderr, "Error: project ID, status and --substitute are required")
+		printStatusRemoveUsage()
+		os.Exit(1)
+	}
+
+	if err := status.Remove(projectID, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printStatusRemoveUsage() {
+	fmt.Println("Usage: bgl status rm [options] [projectId] <status>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId              The project ID or project key (default: the default project)")
+	fmt.Println("  status                 The status ID or name to delete")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --substitute=<status>  Status ID or name that existing issues move to (required)")
+	fmt.Println("  --raw                  Output raw JSON response")
+	fmt.Println("  -y, --yes              Skip confirmation prompt")
+	fmt.Println("  -h, --help             Show this help message")
+}
+
+func handleStatusOrder() {
+	// Parse arguments: bgl status order [--raw] [--yes] <projectId> <status>...
+	args := os.Args[3:]
+
+	opts := status.OrderOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printStatusOrderUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	if len(positional) < 2 {
+		fmt.Fprintln(os.Stderr, "Error: project ID and at least one status are required")
+		printStatusOrderUsage()
+		os.Exit(1)
+	}
+
+	if err := status.Order(positional[0], positional[1:], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printStatusOrderUsage() {
+	fmt.Println("Usage: bgl status order [options] <projectId> <status>...")
+	fmt.Println()
+	fmt.Println("Moves the given statuses to the front in the given order; other")
+	fmt.Println("statuses keep their relative order after them.")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key")
+	fmt.Println("  status      Status IDs or names")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -y, --yes   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleCategory() {
+	if len(os.Args) < 3 {
+		printCategoryUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleCategoryList()
+	case "add":
+		handleCategoryAdd()
+	case "rename":
+		handleCategoryRename()
+	case "delete":
+		handleCategoryDelete()
+	case "-h", "--help", "help":
+		printCategoryUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown category command: %s\n", os.Args[2])
+		printCategoryUsage()
+		os.Exit(1)
+	}
+}
+
+func handleCategoryList() {
+	// Parse arguments: bgl category list [--raw] <projectId>
+	args := os.Args[3:]
+
+	opts := category.ListOptions{}
+	var projectID string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printCategoryListUsage()
+			return
+		default:
+			if projectID == "" {
+				projectID = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printCategoryListUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectID == "" {
+		projectID = defaultProject()
+	}
+
+	if projectID == "" {
+		fmt.Fprintln(os.Stderr, "Error: project ID is required")
+		printCategoryListUsage()
+		os.Exit(1)
+	}
+
+	if err := category.List(projectID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printCategoryUsage() {
+	fmt.Println("Usage: bgl category <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] <projectId>   List categories for a project")
+	fmt.Println("  add [options] <projectId> <name>")
+	fmt.Println("                             Add a category")
+	fmt.Println("  rename [options] <projectId> <category> <newName>")
+	fmt.Println("                             Rename a category")
+	fmt.Println("  delete [options] <projectId> <category>")
+	fmt.Println("                             Delete a category")
+}
+
+func printCategoryListUsage() {
+	fmt.Println("Usage: bgl category list [options] <projectId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleCategoryAdd() {
+	// Parse arguments: bgl category add [--raw] [--yes] [projectId] <name>
+	args := os.Args[3:]
+
+	opts := category.AddOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printCategoryAddUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	projectID, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project ID and category name are required")
+		printCategoryAddUsage()
+		os.Exit(1)
+	}
+
+	if err := category.Add(projectID, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printCategoryAddUsage() {
+	fmt.Println("Usage: bgl category add [options] [projectId] <name>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key (default: the default project)")
+	fmt.Println("  name        The new category name")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -y, --yes   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleCategoryRename() {
+	// Parse arguments: bgl category rename [--raw] [--yes] [projectId] <category> <newName>
+	args := os.Args[3:]
+
+	opts := category.RenameOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printCategoryRenameUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	projectID, rest, ok := projectArgs(positional, 2)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project ID, category and new name are required")
+		printCategoryRenameUsage()
+		os.Exit(1)
+	}
+
+	if err := category.Rename(projectID, rest[0], rest[1], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printCategoryRenameUsage() {
+	fmt.Println("Usage: bgl category rename [options] [projectId] <category> <newName>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key (default: the default project)")
+	fmt.Println("  category    The category ID or name")
+	fmt.Println("  newName     The new category name")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -y, --yes   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleCategoryDelete() {
+	// Parse arguments: bgl category delete [--raw] [--yes] [projectId] <category>
+	args := os.Args[3:]
+
+	opts := category.DeleteOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printCategoryDeleteUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	projectID, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project ID and category are required")
+		printCategoryDeleteUsage()
+		os.Exit(1)
+	}
+
+	if err := category.Delete(projectID, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printCategoryDeleteUsage() {
+	fmt.Println("Usage: bgl category delete [options] [projectId] <category>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key (default: the default project)")
+	fmt.Println("  category    The category ID or name")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -y, --yes   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+// projectArgs splits positional arguments into a leading project ID and n
+// further arguments. The project may be omitted in favor of the default
+// project.
+func projectArgs(positional []string, n int) (string, []string, bool) {
+	switch len(positional) {
+	case n + 1:
+		return positional[0], positional[1:], true
+	case n:
+		if project := defaultProject(); project != "" {
+			return project, positional, true
+		}
+	}
+	return "", nil, false
+}
+
+func handleMilestone() {
+	if len(os.Args) < 3 {
+		printMilestoneUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleMilestoneList()
+	case "add":
+		handleMilestoneAdd()
+	case "edit":
+		handleMilestoneEdit()
+	case "close":
+		handleMilestoneClose()
+	case "-h", "--help", "help":
+		printMilestoneUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown milestone command: %s\n", os.Args[2])
+		printMilestoneUsage()
+		os.Exit(1)
+	}
+}
+
+func handleMilestoneList() {
+	// Parse arguments: bgl milestone list [--raw] <projectId>
+	args := os.Args[3:]
+
+	opts := milestone.ListOptions{}
+	var projectID string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printMilestoneListUsage()
+			return
+		default:
+			if projectID == "" {
+				projectID = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printMilestoneListUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectID == "" {
+		projectID = defaultProject()
+	}
+
+	if projectID == "" {
+		fmt.Fprintln(os.Stderr, "Error: project ID is required")
+		printMilestoneListUsage()
+		os.Exit(1)
+	}
+
+	if err := milestone.List(projectID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printMilestoneUsage() {
+	fmt.Println("Usage: bgl milestone <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] <projectId>   List versions/milestones for a project")
+	fmt.Println("  add [options] <projectId> <name>")
+	fmt.Println("                             Add a version/milestone")
+	fmt.Println("  edit [options] <projectId> <milestone>")
+	fmt.Println("                             Update a version/milestone")
+	fmt.Println("  close [options] <projectId> <milestone>")
+	fmt.Println("                             Archive a released version/milestone")
+}
+
+func printMilestoneListUsage() {
+	fmt.Println("Usage: bgl milestone list [options] <projectId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleMilestoneAdd() {
+	// Parse arguments: bgl milestone add [--raw] [--yes] [--description=] [--start=] [--due=] [projectId] <name>
+	args := os.Args[3:]
+
+	opts := milestone.AddOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printMilestoneAddUsage()
+			return
+		case strings.HasPrefix(arg, "--description="):
+			opts.Description = strings.TrimPrefix(arg, "--description=")
+		case strings.HasPrefix(arg, "--start="):
+			opts.StartDate = strings.TrimPrefix(arg, "--start=")
+		case strings.HasPrefix(arg, "--due="):
+			opts.DueDate = strings.TrimPrefix(arg, "--due=")
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printMilestoneAddUsage()
+			os.Exit(1)
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectID, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project ID and name are required")
+		printMilestoneAddUsage()
+		os.Exit(1)
+	}
+
+	if err := milestone.Add(projectID, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printMilestoneAddUsage() {
+	fmt.Println("Usage: bgl milestone add [options] [projectId] <name>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId             The project ID or project key (default: the default project)")
+	fmt.Println("  name                  The version/milestone name")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --description=<text>  Description")
+	fmt.Println("  --start=<yyyy-MM-dd>  Start date")
+	fmt.Println("  --due=<yyyy-MM-dd>    Release due date")
+	fmt.Println("  --raw                 Output raw JSON response")
+	fmt.Println("  -y, --yes             Skip confirmation prompt")
+	fmt.Println("  -h, --help            Show this help message")
+}
+
+func handleMilestoneEdit() {
+	// Parse arguments: bgl milestone edit [--raw] [--yes] [--name=] [--description=] [--start=] [--due=] [--archived=] [projectId] <milestone>
+	args := os.Args[3:]
+
+	opts := milestone.EditOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printMilestoneEditUsage()
+			return
+		case strings.HasPrefix(arg, "--name="):
+			opts.Name = strings.TrimPrefix(arg, "--name=")
+		case strings.HasPrefix(arg, "--description="):
+			opts.Description = strings.TrimPrefix(arg, "--description=")
+		case strings.HasPrefix(arg, "--start="):
+			opts.StartDate = strings.TrimPrefix(arg, "--start=")
+		case strings.HasPrefix(arg, "--due="):
+			opts.DueDate = strings.TrimPrefix(arg, "--due=")
+		case strings.HasPrefix(arg, "--archived="):
+			archived, err := strconv.ParseBool(strings.TrimPrefix(arg, "--archived="))
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error: --archived must be true or false")
+				printMilestoneEditUsage()
+				os.Exit(1)
+			}
+			opts.Archived = &archived
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printMilestoneEditUsage()
+			os.Exit(1)
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectID, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project ID and milestone are required")
+		printMilestoneEditUsage()
+		os.Exit(1)
+	}
+
+	if err := milestone.Edit(projectID, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printMilestoneEditUsage() {
+	fmt.Println("Usage: bgl milestone edit [options] [projectId] <milestone>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId               The project ID or project key (default: the default project)")
+	fmt.Println("  milestone               The version/milestone ID or name")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --name=<name>           New name")
+	fmt.Println("  --description=<text>    New description")
+	fmt.Println("  --start=<yyyy-MM-dd>    New start date")
+	fmt.Println("  --due=<yyyy-MM-dd>      New release due date")
+	fmt.Println("  --archived=<true|false> Archive or unarchive")
+	fmt.Println("  --raw                   Output raw JSON response")
+	fmt.Println("  -y, --yes               Skip confirmation prompt")
+	fmt.Println("  -h, --help              Show this help message")
+}
+
+func handleMilestoneClose() {
+	// Parse arguments: bgl milestone close [--raw] [--yes] [projectId] <milestone>
+	args := os.Args[3:]
+
+	opts := milestone.CloseOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printMilestoneCloseUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	projectID, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project ID and milestone are required")
+		printMilestoneCloseUsage()
+		os.Exit(1)
+	}
+
+	if err := milestone.Close(projectID, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printMilestoneCloseUsage() {
+	fmt.Println("Usage: bgl milestone close [options] [projectId] <milestone>")
+	fmt.Println()
+	fmt.Println("Archives a released version/milestone.")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key (default: the default project)")
+	fmt.Println("  milestone   The version/milestone ID or name")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -y, --yes   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handlePriority() {
+	if len(os.Args) < 3 {
+		printPriorityUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handlePriorityList()
+	case "-h", "--help", "help":
+		printPriorityUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown priority command: %s\n", os.Args[2])
+		printPriorityUsage()
+		os.Exit(1)
+	}
+}
+
+func handlePriorityList() {
+	// Parse arguments: bgl priority list [--raw]
+	args := os.Args[3:]
+
+	opts := priority.ListOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printPriorityListUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printPriorityListUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := priority.List(opts); err != nil {
+		fail(err)
+	}
+}
+
+func printPriorityUsage() {
+	fmt.Println("Usage: bgl priority <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw]   List priorities")
+}
+
+func printPriorityListUsage() {
+	fmt.Println("Usage: bgl priority list [options]")
+	fmt.Println()
+	fmt.Println("Lists the priorities of the space with their IDs.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleResolution() {
+	if len(os.Args) < 3 {
+		printResolutionUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleResolutionList()
+	case "-h", "--help", "help":
+		printResolutionUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown resolution command: %s\n", os.Args[2])
+		printResolutionUsage()
+		os.Exit(1)
+	}
+}
+
+func handleResolutionList() {
+	// Parse arguments: bgl resolution list [--raw]
+	args := os.Args[3:]
+
+	opts := resolution.ListOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printResolutionListUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printResolutionListUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := resolution.List(opts); err != nil {
+		fail(err)
+	}
+}
+
+func printResolutionUsage() {
+	fmt.Println("Usage: bgl resolution <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw]   List resolutions")
+}
+
+func printResolutionListUsage() {
+	fmt.Println("Usage: bgl resolution list [options]")
+	fmt.Println()
+	fmt.Println("Lists the resolutions of the space with their IDs.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleIssueType() {
+	if len(os.Args) < 3 {
+		printIssueTypeUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleIssueTypeList()
+	case "add":
+		handleIssueTypeAdd()
+	case "delete":
+		handleIssueTypeDelete()
+	case "-h", "--help", "help":
+		printIssueTypeUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown issuetype command: %s\n", os.Args[2])
+		printIssueTypeUsage()
+		os.Exit(1)
+	}
+}
+
+func handleIssueTypeList() {
+	// Parse arguments: bgl issuetype list [--raw] <projectId>
+	args := os.Args[3:]
+
+	opts := issuetype.ListOptions{}
+	var projectID string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printIssueTypeListUsage()
+			return
+		default:
+			if projectID == "" {
+				projectID = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printIssueTypeListUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectID == "" {
+		projectID = defaultProject()
+	}
+
+	if projectID == "" {
+		fmt.Fprintln(os.Stderr, "Error: project ID is required")
+		printIssueTypeListUsage()
+		os.Exit(1)
+	}
+
+	if err := issuetype.List(projectID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printIssueTypeUsage() {
+	fmt.Println("Usage: bgl issuetype <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] <projectId>   List issue types for a project")
+	fmt.Println("  add [options] <projectId>  Add an issue type")
+	fmt.Println("  delete [options] <projectId> <issueType>")
+	fmt.Println("                             Delete an issue type")
+}
+
+func printIssueTypeListUsage() {
+	fmt.Println("Usage: bgl issuetype list [options] <projectId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleIssueTypeAdd() {
+	// Parse arguments: bgl issuetype add [--raw] [--yes] --name=<name> --color=<color> <projectId>
+	args := os.Args[3:]
+
+	opts := issuetype.AddOptions{}
+	var projectID string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printIssueTypeAddUsage()
+			return
+		case arg == "--name" || arg == "--color":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printIssueTypeAddUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--name" {
+				opts.Name = args[i]
+			} else {
+				opts.Color = args[i]
+			}
+		case strings.HasPrefix(arg, "--name="):
+			opts.Name = strings.TrimPrefix(arg, "--name=")
+		case strings.HasPrefix(arg, "--color="):
+			opts.Color = strings.TrimPrefix(arg, "--color=")
+		default:
+			if projectID == "" {
+				projectID = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printIssueTypeAddUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectID == "" {
+		projectID = defaultProject()
+	}
+
+	if projectID == "" || opts.Name == "" || opts.Color == "" {
+		fmt.Fprintln(os.Stderr, "Error: project ID, --name and --color are required")
+		printIssueTypeAddUsage()
+		os.Exit(1)
+	}
+
+	if err := issuetype.Add(projectID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printIssueTypeAddUsage() {
+	fmt.Println("Usage: bgl issuetype add [options] <projectId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId        The project ID or project key (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --name=<name>    Issue type name (required)")
+	fmt.Println("  --color=<color>  Issue type color (required), one of:")
+	fmt.Println("                   #e30000 #990000 #934981 #814fbc #2779ca")
+	fmt.Println("                   #007e9a #7ea800 #ff9200 #ff3265 #666665")
+	fmt.Println("  --raw            Output raw JSON response")
+	fmt.Println("  -y, --yes        Skip confirmation prompt")
+	fmt.Println("  -h, --help       Show this help message")
+}
+
+func handleIssueTypeDelete() {
+	// Parse arguments: bgl issuetype delete [--raw] [--yes] --substitute=<issueType> [projectId] <issueType>
+	args := os.Args[3:]
+
+	opts := issuetype.DeleteOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printIssueTypeDeleteUsage()
+			return
+		case arg == "--substitute":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printIssueTypeDeleteUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Substitute = args[i]
+		case strings.HasPrefix(arg, "--substitute="):
+			opts.Substitute = strings.TrimPrefix(arg, "--substitute=")
+		default:
+			if len(positional) == 2 {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printIssueTypeDeleteUsage()
+				os.Exit(1)
+			}
+			positional = append(positional, arg)
+		}
+	}
+
+	var projectID, issueType string
+	switch len(positional) {
+	case 1:
+		projectID = defaultProject()
+		issueType = positional[0]
+	case 2:
+		projectID = positional[0]
+		issueType = positional[1]
+	}
+
+	if projectID == "" || issueType == "" || opts.Substitute == "" {
+		fmt.Fprintln(os.Stderr, "Error: project ID, issue type and --substitute are required")
+		printIssueTypeDeleteUsage()
+		os.Exit(1)
+	}
+
+	if err := issuetype.Delete(projectID, issueType, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printIssueTypeDeleteUsage() {
+	fmt.Println("Usage: bgl issuetype delete [options] [projectId] <issueType>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId            The project ID or project key (default: the default project)")
+	fmt.Println("  issueType            The issue type ID or name to delete")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --substitute=<type>  Issue type ID or name that existing issues move to (required)")
+	fmt.Println("  --raw                Output raw JSON response")
+	fmt.Println("  -y, --yes            Skip confirmation prompt")
+	fmt.Println("  -h, --help           Show this help message")
+}
+
+func handleProject() {
+	if len(os.Args) < 3 {
+		printProjectUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleProjectList()
+	case "view":
+		handleProjectView()
+	case "onboard":
+		handleProjectOnboard()
+	case "fields":
+		handleProjectFields()
+	case "activity":
+		handleProjectActivity()
+	case "disk-usage":
+		handleProjectDiskUsage()
+	case "create":
+		handleProjectCreate()
+	case "edit":
+		handleProjectEdit()
+	case "user":
+		handleProjectUser()
+	case "teams":
+		handleProjectTeams()
+	case "-h", "--help", "help":
+		printProjectUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown project command: %s\n", os.Args[2])
+		printProjectUsage()
+		os.Exit(1)
+	}
+}
+
+func handleProjectList() {
+	// Parse arguments: bgl project list [--raw]
+	args := os.Args[3:]
+
+	opts := project.ListOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printProjectListUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printProjectListUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := project.List(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleProjectView() {
+	// Parse arguments: bgl project view [--raw] [projectKey]
+	args := os.Args[3:]
+
+	opts := project.ViewOptions{}
+	var projectKey string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printProjectViewUsage()
+			return
+		default:
+			if projectKey == "" {
+				projectKey = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printProjectViewUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key is required")
+		printProjectViewUsage()
+		os.Exit(1)
+	}
+
+	if err := project.View(projectKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printProjectUsage() {
+	fmt.Println("Usage: bgl project <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw]   List projects")
+	fmt.Println("  view [--raw] [projectKey]   View a project's settings")
+	fmt.Println("  onboard [--yes] --user=<user> <projectKey>   Add a member with an onboarding issue")
+	fmt.Println("  fields [--raw] [projectKey]   List a project's custom fields")
+	fmt.Println("  activity [--raw] [--limit <n>] [--type <types>] [projectKey]   Show a project's recent activity")
+	fmt.Println("  disk-usage [--raw] [projectKey]   Show a project's disk usage")
+	fmt.Println("  create [--yes] --key <key> --name <name> [options]   Create a project")
+	fmt.Println("  edit [--yes] [options] <projectKey>   Update a project's settings")
+	fmt.Println("  user add|remove [--yes] <projectKey> <user>   Add or remove a project member")
+	fmt.Println("  teams [--raw] [projectKey]   List teams attached to a project")
+}
+
+func printProjectListUsage() {
+	fmt.Println("Usage: bgl project list [options]")
+	fmt.Println()
+	fmt.Println("Lists the projects you can access, with key, name, and archived state.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleProjectOnboard() {
+	// Parse arguments: bgl project onboard [--yes] --user=<user> [--template=<issueKey>] <projectKey>
+	args := os.Args[3:]
+
+	opts := project.OnboardOptions{}
+	var projectKey string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printProjectOnboardUsage()
+			return
+		case arg == "--user" || arg == "--template":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printProjectOnboardUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--user" {
+				opts.User = args[i]
+			} else {
+				opts.TemplateIssue = args[i]
+			}
+		case strings.HasPrefix(arg, "--user="):
+			opts.User = strings.TrimPrefix(arg, "--user=")
+		case strings.HasPrefix(arg, "--template="):
+			opts.TemplateIssue = strings.TrimPrefix(arg, "--template=")
+		default:
+			if projectKey == "" {
+				projectKey = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printProjectOnboardUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" || opts.User == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key and --user are required")
+		printProjectOnboardUsage()
+		os.Exit(1)
+	}
+
+	if err := project.Onboard(projectKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printProjectOnboardUsage() {
+	fmt.Println("Usage: bgl project onboard [options] --user=<user> <projectKey>")
+	fmt.Println()
+	fmt.Println("Adds the user to the project, creates an onboarding issue assigned to")
+	fmt.Println("them from a template issue, and posts a welcome comment with links.")
+	fmt.Println("Requires administrator rights.")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey  The project key (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --user=<user>         The user to onboard (ID, user ID, name, or mail)")
+	fmt.Println("  --template=<issueKey> The template issue (default: onboarding.template_issue in the config)")
+	fmt.Println("  --yes, -y             Skip confirmation prompt")
+	fmt.Println("  -h, --help            Show this help message")
+}
+
+func printProjectViewUsage() {
+	fmt.Println("Usage: bgl project view [options] [projectKey]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey  The project key or ID (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleProjectFields() {
+	// Parse arguments: bgl project fields [--raw] [projectKey]
+	args := os.Args[3:]
+
+	opts := project.FieldsOptions{}
+	var projectKey string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printProjectFieldsUsage()
+			return
+		default:
+			if projectKey == "" {
+				projectKey = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printProjectFieldsUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key is required")
+		printProjectFieldsUsage()
+		os.Exit(1)
+	}
+
+	if err := project.Fields(projectKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printProjectFieldsUsage() {
+	fmt.Println("Usage: bgl project fields [options] [projectKey]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey  The project key or ID (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleProjectActivity() {
+	// Parse arguments: bgl project activity [--raw] [--limit <n>] [--type <types>] [projectKey]
+	args := os.Args[3:]
+
+	opts := project.ActivityOptions{}
+	var projectKey string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printProjectActivityUsage()
+			return
+		case arg == "--limit" || arg == "-n" || arg == "--type":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printProjectActivityUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--type" {
+				opts.Types = args[i]
+			} else {
+				opts.Limit = parseActivityLimit(args[i])
+			}
+		case strings.HasPrefix(arg, "--limit="):
+			opts.Limit = parseActivityLimit(strings.TrimPrefix(arg, "--limit="))
+		case strings.HasPrefix(arg, "--type="):
+			opts.Types = strings.TrimPrefix(arg, "--type=")
+		default:
+			if projectKey == "" {
+				projectKey = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printProjectActivityUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key is required")
+		printProjectActivityUsage()
+		os.Exit(1)
+	}
+
+	if err := project.Activity(projectKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+// parseActivityLimit parses an activity --limit value, exiting on error.
+func parseActivityLimit(s string) int {
+	limit, err := strconv.Atoi(s)
+	if err != nil || limit <= 0 {
+		fmt.Fprintf(os.Stderr, "Error: invalid limit: %s\n", s)
+		os.Exit(1)
+	}
+	return limit
+}
+
+func printProjectActivityUsage() {
+	fmt.Println("Usage: bgl project activity [options] [projectKey]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey           The project key or ID (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -n, --limit <n>      Number of activities to show (default: 20)")
+	fmt.Println("  --type <types>       Comma-separated activity types or groups to show:")
+	fmt.Println("                       issue, comment, wiki, file, svn, git, pull-request,")
+	fmt.Println("                       milestone, project, or a type from 'bgl webhook add --help'")
+	fmt.Println("  --raw                Output raw JSON response")
+	fmt.Println("  -h, --help           Show this help message")
+}
+
+func handleProjectDiskUsage() {
+	// Parse arguments: bgl project disk-usage [--raw] [projectKey]
+	args := os.Args[3:]
+
+	opts := project.DiskUsageOptions{}
+	var projectKey string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printProjectDiskUsageUsage()
+			return
+		default:
+			if projectKey == "" {
+				projectKey = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printProjectDiskUsageUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key is required")
+		printProjectDiskUsageUsage()
+		os.Exit(1)
+	}
+
+	if err := project.DiskUsage(projectKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printProjectDiskUsageUsage() {
+	fmt.Println("Usage: bgl project disk-usage [options] [projectKey]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey  The project key or ID (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleProjectCreate() {
+	// Parse arguments: bgl project create [--raw] [--yes] --key <key> --name <name> [settings]
+	args := os.Args[3:]
+
+	opts := project.CreateOptions{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch arg {
+		case "--raw":
+			opts.Raw = true
+			continue
+		case "--yes", "-y":
+			opts.Yes = true
+			continue
+		case "-h", "--help":
+			printProjectCreateUsage()
+			return
+		}
+		next, err := parseProjectSetting(args, i, &opts.Settings)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			printProjectCreateUsage()
+			os.Exit(1)
+		}
+		i = next
+	}
+
+	if opts.Key == "" || opts.Name == "" {
+		fmt.Fprintln(os.Stderr, "Error: --key and --name are required")
+		printProjectCreateUsage()
+		os.Exit(1)
+	}
+
+	if err := project.Create(opts); err != nil {
+		fail(err)
+	}
+}
+
+func printProjectCreateUsage() {
+	fmt.Println("Usage: bgl project create [options] --key <key> --name <name>")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --key <key>              Project key: uppercase letters, digits, and underscores (required)")
+	fmt.Println("  --name <name>            Project name (required)")
+	printProjectSettingsOptions()
+	fmt.Println("  --raw                    Output raw JSON response")
+	fmt.Println("  -y, --yes                Skip confirmation prompt")
+	fmt.Println("  -h, --help               Show this help message")
+	fmt.Println()
+	fmt.Println("Charts and subtasking are off and text formatting is markdown unless set.")
+}
+
+func handleProjectEdit() {
+	// Parse arguments: bgl project edit [--raw] [--yes] [--archived[=<bool>]] [settings] [projectKey]
+	args := os.Args[3:]
+
+	opts := project.EditOptions{}
+	var projectKey string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+			continue
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+			continue
+		case arg == "-h" || arg == "--help":
+			printProjectEditUsage()
+			return
+		case arg == "--archived" || strings.HasPrefix(arg, "--archived="):
+			archived, err := parseBoolFlag(arg, "--archived")
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				printProjectEditUsage()
+				os.Exit(1)
+			}
+			opts.Archived = &archived
+			continue
+		case !strings.HasPrefix(arg, "-"):
+			if projectKey != "" {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printProjectEditUsage()
+				os.Exit(1)
+			}
+			projectKey = arg
+			continue
+		}
+		next, err := parseProjectSetting(args, i, &opts.Settings)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			printProjectEditUsage()
+			os.Exit(1)
+		}
+		i = next
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key is required")
+		printProjectEditUsage()
+		os.Exit(1)
+	}
+
+	if err := project.Edit(projectKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printProjectEditUsage() {
+	fmt.Println("Usage: bgl project edit [options] [projectKey]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey               The project key or ID (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --key <key>              New project key")
+	fmt.Println("  --name <name>            New project name")
+	printProjectSettingsOptions()
+	fmt.Println("  --archived[=<bool>]      Archive or unarchive the project")
+	fmt.Println("  --raw                    Output raw JSON response")
+	fmt.Println("  -y, --yes                Skip confirmation prompt")
+	fmt.Println("  -h, --help               Show this help message")
+}
+
+func handleProjectUser() {
+	// Parse arguments: bgl project user add|remove [--raw] [--yes] [projectKey] <user>
+	if len(os.Args) < 4 {
+		printProjectUserUsage()
+		os.Exit(1)
+	}
+
+	var action func(string, string, project.UserOptions) error
+	switch os.Args[3] {
+	case "add":
+		action = project.UserAdd
+	case "remove":
+		action = project.UserRemove
+	case "-h", "--help", "help":
+		printProjectUserUsage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown project user command: %s\n", os.Args[3])
+		printProjectUserUsage()
+		os.Exit(1)
+	}
+
+	args := os.Args[4:]
+	opts := project.UserOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printProjectUserUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key and user are required")
+		printProjectUserUsage()
+		os.Exit(1)
+	}
+
+	if err := action(projectKey, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printProjectUserUsage() {
+	fmt.Println("Usage: bgl project user add|remove [options] [projectKey] <user>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  add         Add a space user to the project (requires administrator rights)")
+	fmt.Println("  remove      Remove a member from the project")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey  The project key or ID (default: the default project)")
+	fmt.Println("  user        Mail address, name, user ID, or numeric ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -y, --yes   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleProjectTeams() {
+	// Parse arguments: bgl project teams [--raw] [projectKey]
+	args := os.Args[3:]
+
+	opts := project.TeamsOptions{}
+	var projectKey string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printProjectTeamsUsage()
+			return
+		default:
+			if projectKey == "" {
+				projectKey = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printProjectTeamsUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key is required")
+		printProjectTeamsUsage()
+		os.Exit(1)
+	}
+
+	if err := project.Teams(projectKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printProjectTeamsUsage() {
+	fmt.Println("Usage: bgl project teams [options] [projectKey]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey  The project key or ID (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printProjectSettingsOptions() {
+	fmt.Println("  --text-formatting <rule> Text formatting rule: markdown or backlog")
+	fmt.Println("  --chart[=<bool>]         Enable Gantt and burndown charts")
+	fmt.Println("  --subtasking[=<bool>]    Enable parent/child issues")
+	fmt.Println("  --wiki[=<bool>]          Enable the wiki")
+	fmt.Println("  --file-sharing[=<bool>]  Enable file sharing")
+	fmt.Println("  --dev-attributes[=<bool>]")
+	fmt.Println("                           Enable development attributes")
+}
+
+// parseProjectSetting parses the project setting option at args[i] into
+// settings and returns the index of the last argument consumed.
+func parseProjectSetting(args []string, i int, settings *project.Settings) (int, error) {
+	arg := args[i]
+	name, value, hasValue := strings.Cut(arg, "=")
+
+	switch name {
+	case "--key", "--name", "--text-formatting":
+		if !hasValue {
+			if i+1 >= len(args) {
+				return i, fmt.Errorf("%s requires a value", name)
+			}
+			i++
+			value = args[i]
+		}
+		switch name {
+		case "--key":
+			settings.Key = value
+		case "--name":
+			settings.Name = value
+		default:
+			settings.TextFormattingRule = value
+		}
+		return i, nil
+	}
+
+	flags := map[string]**bool{
+		"--chart":          &settings.ChartEnabled,
+		"--subtasking":     &settings.SubtaskingEnabled,
+		"--wiki":           &settings.UseWiki,
+		"--file-sharing":   &settings.UseFileSharing,
+		"--dev-attributes": &settings.UseDevAttributes,
+	}
+	if field, ok := flags[name]; ok {
+		enabled, err := parseBoolFlag(arg, name)
+		if err != nil {
+			return i, err
+		}
+		*field = &enabled
+		return i, nil
+	}
+
+	if strings.HasPrefix(arg, "-") {
+		return i, fmt.Errorf("unknown option: %s", arg)
+	}
+	return i, fmt.Errorf("unexpected argument: %s", arg)
+}
+
+// parseBoolFlag parses "--flag" as true and "--flag=<bool>" as its value.
+func parseBoolFlag(arg string, name string) (bool, error) {
+	if arg == name {
+		return true, nil
+	}
+	value, err := strconv.ParseBool(strings.TrimPrefix(arg, name+"="))
+	if err != nil {
+		return false, fmt.Errorf("%s must be true or false", name)
+	}
+	return value, nil
+}
+
+func handleUser() {
+	if len(os.Args) < 3 {
+		printUserUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "me":
+		handleUserMe()
+	case "list":
+		handleUserList()
+	case "view":
+		handleUserView()
+	case "activity":
+		handleUserActivity()
+	case "icon":
+		handleUserIcon()
+	case "-h", "--help", "help":
+		printUserUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown user command: %s\n", os.Args[2])
+		printUserUsage()
+		os.Exit(1)
+	}
+}
+
+func handleUserMe() {
+	// Parse arguments: bgl user me [--raw]
+	args := os.Args[3:]
+
+	opts := user.MeOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printUserMeUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printUserMeUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := user.Me(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleUserList() {
+	// Parse arguments: bgl user list [--raw] [--query <text>]
+	args := os.Args[3:]
+
+	opts := user.ListOptions{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printUserListUsage()
+			return
+		case arg == "--query" || arg == "-q":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printUserListUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Query = args[i]
+		case strings.HasPrefix(arg, "--query="):
+			opts.Query = strings.TrimPrefix(arg, "--query=")
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+			printUserListUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := user.List(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleUserView() {
+	// Parse arguments: bgl user view [--raw] <user>
+	args := os.Args[3:]
+
+	opts := user.ViewOptions{}
+	var query string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printUserViewUsage()
+			return
+		default:
+			if query == "" {
+				query = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printUserViewUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if query == "" {
+		fmt.Fprintln(os.Stderr, "Error: user is required")
+		printUserViewUsage()
+		os.Exit(1)
+	}
+
+	if err := user.View(query, opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleUserActivity() {
+	// Parse arguments: bgl user activity [--raw] [--limit <n>] [user]
+	args := os.Args[3:]
+
+	opts := user.ActivityOptions{}
+	var query string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printUserActivityUsage()
+			return
+		case arg == "--limit" || arg == "-n":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printUserActivityUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Limit = parseActivityLimit(args[i])
+		case strings.HasPrefix(arg, "--limit="):
+			opts.Limit = parseActivityLimit(strings.TrimPrefix(arg, "--limit="))
+		default:
+			if query == "" {
+				query = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printUserActivityUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if query == "" {
+		query = "me"
+	}
+
+	if err := user.Activity(query, opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleUserIcon() {
+	// Parse arguments: bgl user icon [-o <file>] <user>
+	args := os.Args[3:]
+
+	opts := user.IconOptions{}
+	var query string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "-h" || arg == "--help":
+			printUserIconUsage()
+			return
+		case arg == "--output" || arg == "-o":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printUserIconUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Output = args[i]
+		case strings.HasPrefix(arg, "--output="):
+			opts.Output = strings.TrimPrefix(arg, "--output=")
+		default:
+			if query == "" {
+				query = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printUserIconUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if query == "" {
+		fmt.Fprintln(os.Stderr, "Error: user is required")
+		printUserIconUsage()
+		os.Exit(1)
+	}
+
+	if err := user.Icon(query, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printUserUsage() {
+	fmt.Println("Usage: bgl user <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  me [--raw]   Show the logged-in user")
+	fmt.Println("  list [--raw] [--query <text>]   List users in the space")
+	fmt.Println("  view [--raw] <user>   View a user")
+	fmt.Println("  activity [--raw] [--limit <n>] [user]   Show a user's recent activity")
+	fmt.Println("  icon [-o <file>] <user>   Download a user's icon")
+}
+
+func printUserMeUsage() {
+	fmt.Println("Usage: bgl user me [options]")
+	fmt.Println()
+	fmt.Println("Shows the user you are logged in as: ID, name, mail, role, and language.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printUserListUsage() {
+	fmt.Println("Usage: bgl user list [options]")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --query, -q <text>   Only list users whose name, user ID, or mail contains text")
+	fmt.Println("  --raw                Output raw JSON response")
+	fmt.Println("  -h, --help           Show this help message")
+}
+
+func printUserViewUsage() {
+	fmt.Println("Usage: bgl user view [options] <user>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  user        me, or a user's numeric ID, user ID, name, or mail address")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printUserActivityUsage() {
+	fmt.Println("Usage: bgl user activity [options] [user]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  user                 me (default), or a user's numeric ID, user ID, name, or mail address")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -n, --limit <n>      Number of activities to show (default: 30)")
+	fmt.Println("  --raw                Output raw JSON response")
+	fmt.Println("  -h, --help           Show this help message")
+}
+
+func printUserIconUsage() {
+	fmt.Println("Usage: bgl user icon [options] <user>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  user                 me, or a user's numeric ID, user ID, name, or mail address")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --output, -o <file>  File to write, or - for standard output (default: the server's file name)")
+	fmt.Println("  -h, --help           Show this help message")
+}
+
+func handleSpace() {
+	if len(os.Args) < 3 {
+		printSpaceUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "info":
+		handleSpaceInfo()
+	case "activity":
+		handleSpaceActivity()
+	case "notification":
+		handleSpaceNotification()
+	case "disk-usage":
+		handleSpaceDiskUsage()
+	case "licence", "license":
+		handleSpaceLicence()
+	case "capabilities":
+		handleSpaceCapabilities()
+	case "-h", "--help", "help":
+		printSpaceUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown space command: %s\n", os.Args[2])
+		printSpaceUsage()
+		os.Exit(1)
+	}
+}
+
+func handleSpaceInfo() {
+	// Parse arguments: bgl space info [--raw]
+	args := os.Args[3:]
+
+	opts := space.InfoOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printSpaceInfoUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printSpaceInfoUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := space.Info(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleSpaceActivity() {
+	// Parse arguments: bgl space activity [--raw] [--limit <n>] [--type <types>]
+	args := os.Args[3:]
+
+	opts := space.ActivityOptions{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printSpaceActivityUsage()
+			return
+		case arg == "--limit" || arg == "-n" || arg == "--type":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printSpaceActivityUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--type" {
+				opts.Types = args[i]
+			} else {
+				opts.Limit = parseActivityLimit(args[i])
+			}
+		case strings.HasPrefix(arg, "--limit="):
+			opts.Limit = parseActivityLimit(strings.TrimPrefix(arg, "--limit="))
+		case strings.HasPrefix(arg, "--type="):
+			opts.Types = strings.TrimPrefix(arg, "--type=")
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+			printSpaceActivityUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := space.Activity(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleSpaceNotification() {
+	// Parse arguments: bgl space notification [--raw] [--yes] [--set <text>]
+	args := os.Args[3:]
+
+	opts := space.NotificationOptions{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printSpaceNotificationUsage()
+			return
+		case arg == "--set":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printSpaceNotificationUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Set = true
+			opts.Content = args[i]
+		case strings.HasPrefix(arg, "--set="):
+			opts.Set = true
+			opts.Content = strings.TrimPrefix(arg, "--set=")
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+			printSpaceNotificationUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := space.Notification(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleSpaceDiskUsage() {
+	// Parse arguments: bgl space disk-usage [--raw]
+	args := os.Args[3:]
+
+	opts := space.DiskUsageOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw", "--json":
+			opts.Raw = true
+		case "-h", "--help":
+			printSpaceDiskUsageUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printSpaceDiskUsageUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := space.DiskUsage(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleSpaceLicence() {
+	// Parse arguments: bgl space licence [--raw]
+	args := os.Args[3:]
+
+	opts := space.LicenceOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printSpaceLicenceUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printSpaceLicenceUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := space.Licence(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleSpaceCapabilities() {
+	// Parse arguments: bgl space capabilities [--raw] [--refresh]
+	args := os.Args[3:]
+
+	opts := space.CapabilitiesOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--refresh":
+			opts.Refresh = true
+		case "-h", "--help":
+			printSpaceCapabilitiesUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printSpaceCapabilitiesUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := space.Capabilities(opts); err != nil {
+		fail(err)
+	}
+}
+
+func printSpaceUsage() {
+	fmt.Println("Usage: bgl space <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  info [--raw]   Show the space's name, owner, and settings")
+	fmt.Println("  activity [--raw] [--limit <n>] [--type <types>]   Show recent activity across all projects")
+	fmt.Println("  notification [--raw] [--yes] [--set <text>]   Show or set the space notification banner")
+	fmt.Println("  disk-usage [--raw]   Show the space's disk usage, in total and per project")
+	fmt.Println("  licence [--raw]   Show the space licence's limits and current usage")
+	fmt.Println("  capabilities [--raw] [--refresh]   Show which plan features the space has")
+}
+
+func printSpaceInfoUsage() {
+	fmt.Println("Usage: bgl space info [options]")
+	fmt.Println()
+	fmt.Println("Shows the name, owner, language, timezone, and report send time of the")
+	fmt.Println("space you are logged in to.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printSpaceActivityUsage() {
+	fmt.Println("Usage: bgl space activity [options]")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -n, --limit <n>      Number of activities to show (default: 20)")
+	fmt.Println("  --type <types>       Comma-separated activity types or groups to show:")
+	fmt.Println("                       issue, comment, wiki, file, svn, git, pull-request,")
+	fmt.Println("                       milestone, project, or a type from 'bgl webhook add --help'")
+	fmt.Println("  --raw                Output raw JSON response")
+	fmt.Println("  -h, --help           Show this help message")
+}
+
+func printSpaceNotificationUsage() {
+	fmt.Println("Usage: bgl space notification [options]")
+	fmt.Println()
+	fmt.Println("Shows the notification banner displayed to every user of the space.")
+	fmt.Println("Setting it requires space administrator rights.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --set <text>  Replace the banner; --set \"\" clears it")
+	fmt.Println("  --yes, -y     Skip confirmation prompt")
+	fmt.Println("  --raw         Output raw JSON response")
+	fmt.Println("  -h, --help    Show this help message")
+}
+
+func printSpaceDiskUsageUsage() {
+	fmt.Println("Usage: bgl space disk-usage [options]")
+	fmt.Println()
+	fmt.Println("Shows the space's disk usage by feature and per project, largest first.")
+	fmt.Println("Requires space administrator rights.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw, --json  Output raw JSON response (sizes in bytes)")
+	fmt.Println("  -h, --help     Show this help message")
+}
+
+func printSpaceLicenceUsage() {
+	fmt.Println("Usage: bgl space licence [options]")
+	fmt.Println()
+	fmt.Println("Shows the space licence's status and its user, project, issue, and")
+	fmt.Println("storage limits. Current user count and storage are shown next to their")
+	fmt.Println("limits when readable; storage needs space administrator rights.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printSpaceCapabilitiesUsage() {
+	fmt.Println("Usage: bgl space capabilities [options]")
+	fmt.Println()
+	fmt.Println("Shows which plan features (Git, wiki attachments, file sharing, ...) the")
+	fmt.Println("space has. Results are cached for 24 hours.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --refresh   Probe the space again instead of using the cache")
+	fmt.Println("  --raw       Output the capabilities as JSON")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleNotification() {
+	if len(os.Args) < 3 {
+		printNotificationUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleNotificationList()
+	case "count":
+		handleNotificationCount()
+	case "watch":
+		handleNotificationWatch()
+	case "-h", "--help", "help":
+		printNotificationUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown notification command: %s\n", os.Args[2])
+		printNotificationUsage()
+		os.Exit(1)
+	}
+}
+
+func handleNotificationList() {
+	// Parse arguments: bgl notification list [--raw] [--unread] [--limit <n>]
+	args := os.Args[3:]
+
+	opts := notification.ListOptions{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--unread":
+			opts.Unread = true
+		case arg == "-h" || arg == "--help":
+			printNotificationListUsage()
+			return
+		case arg == "--limit" || arg == "-n":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printNotificationListUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Limit = parseActivityLimit(args[i])
+		case strings.HasPrefix(arg, "--limit="):
+			opts.Limit = parseActivityLimit(strings.TrimPrefix(arg, "--limit="))
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+			printNotificationListUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := notification.List(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleNotificationCount() {
+	// Parse arguments: bgl notification count [--raw] [--unread]
+	args := os.Args[3:]
+
+	opts := notification.CountOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--unread":
+			opts.Unread = true
+		case "-h", "--help":
+			printNotificationCountUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printNotificationCountUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := notification.Count(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleNotificationWatch() {
+	// Parse arguments: bgl notification watch [--interval <duration>] [--desktop]
+	args := os.Args[3:]
+
+	opts := notification.WatchOptions{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--desktop":
+			opts.Desktop = true
+		case arg == "-h" || arg == "--help":
+			printNotificationWatchUsage()
+			return
+		case arg == "--interval":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printNotificationWatchUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Interval = parseWatchInterval(args[i])
+		case strings.HasPrefix(arg, "--interval="):
+			opts.Interval = parseWatchInterval(strings.TrimPrefix(arg, "--interval="))
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+			printNotificationWatchUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := notification.Watch(opts); err != nil {
+		fail(err)
+	}
+}
+
+// parseWatchInterval parses a --interval value such as "60s" or "5m",
+// exiting on error.
+func parseWatchInterval(s string) time.Duration {
+	interval, err := time.ParseDuration(s)
+	if err != nil || interval <= 0 {
+		fmt.Fprintf(os.Stderr, "Error: invalid interval: %s\n", s)
+		os.Exit(1)
+	}
+	return interval
+}
+
+func printNotificationUsage() {
+	fmt.Println("Usage: bgl notification <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] [--unread] [--limit <n>]   List your notifications")
+	fmt.Println("  count [--raw] [--unread]   Print the number of notifications")
+	fmt.Println("  watch [--interval <duration>] [--desktop]   Print new notifications as they arrive")
+}
+
+func printNotificationListUsage() {
+	fmt.Println("Usage: bgl notification list [options]")
+	fmt.Println()
+	fmt.Println("Lists who assigned, mentioned, or commented at you, newest first.")
+	fmt.Println("Unread notifications are marked with ●.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --unread             Only show unread notifications (among the newest 500)")
+	fmt.Println("  -n, --limit <n>      Number of notifications to show (default: 20)")
+	fmt.Println("  --raw                Output raw JSON response")
+	fmt.Println("  -h, --help           Show this help message")
+}
+
+func printNotificationCountUsage() {
+	fmt.Println("Usage: bgl notification count [options]")
+	fmt.Println()
+	fmt.Println("Prints the number of notifications as a bare integer, for shell prompts")
+	fmt.Println("and status bars. Counts are cached for 30 seconds.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --unread    Only count unread notifications")
+	fmt.Println("  --raw       Output raw JSON response (not cached)")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printNotificationWatchUsage() {
+	fmt.Println("Usage: bgl notification watch [options]")
+	fmt.Println()
+	fmt.Println("Polls your notifications and prints new ones as they arrive, until")
+	fmt.Println("interrupted with Ctrl-C.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --interval <duration>  How often to poll, e.g. 30s or 5m (default: 60s, minimum: 10s)")
+	fmt.Println("  --desktop              Also show a desktop notification (macOS, or Linux with notify-send)")
+	fmt.Println("  -h, --help             Show this help message")
+}
+
+func handleWatching() {
+	if len(os.Args) < 3 {
+		printWatchingUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleWatchingList()
+	case "add":
+		handleWatchingAdd()
+	case "remove":
+		handleWatchingRemove()
+	case "note":
+		handleWatchingNote()
+	case "-h", "--help", "help":
+		printWatchingUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown watching command: %s\n", os.Args[2])
+		printWatchingUsage()
+		os.Exit(1)
+	}
+}
+
+func handleWatchingList() {
+	// Parse arguments: bgl watching list [--raw] [--unread]
+	args := os.Args[3:]
+
+	opts := watching.ListOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--unread":
+			opts.Unread = true
+		case "-h", "--help":
+			printWatchingListUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printWatchingListUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := watching.List(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleWatchingAdd() {
+	// Parse arguments: bgl watching add [--raw] [--note <text>] <issueKey>
+	args := os.Args[3:]
+
+	opts := watching.AddOptions{}
+	var issueKey string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printWatchingAddUsage()
+			return
+		case arg == "--note":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printWatchingAddUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Note = args[i]
+		case strings.HasPrefix(arg, "--note="):
+			opts.Note = strings.TrimPrefix(arg, "--note=")
+		default:
+			if issueKey == "" {
+				issueKey = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printWatchingAddUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if issueKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue key is required")
+		printWatchingAddUsage()
+		os.Exit(1)
+	}
+
+	if err := watching.Add(issueKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleWatchingRemove() {
+	// Parse arguments: bgl watching remove [--raw] [--yes] <watching>
+	args := os.Args[3:]
+
+	opts := watching.RemoveOptions{}
+	var target string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printWatchingRemoveUsage()
+			return
+		default:
+			if target == "" {
+				target = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printWatchingRemoveUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if target == "" {
+		fmt.Fprintln(os.Stderr, "Error: watching ID or issue key is required")
+		printWatchingRemoveUsage()
+		os.Exit(1)
+	}
+
+	if err := watching.Remove(target, opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleWatchingNote() {
+	// Parse arguments: bgl watching note [--raw] <watching> <text>
+	args := os.Args[3:]
+
+	opts := watching.NoteOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printWatchingNoteUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	if len(positional) != 2 {
+		fmt.Fprintln(os.Stderr, "Error: watching ID or issue key, and note text are required")
+		printWatchingNoteUsage()
+		os.Exit(1)
+	}
+
+	if err := watching.Note(positional[0], positional[1], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWatchingUsage() {
+	fmt.Println("Usage: bgl watching <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] [--unread]   List the issues you are watching")
+	fmt.Println("  add [--note <text>] <issueKey>   Watch an issue")
+	fmt.Println("  remove [--yes] <watching>   Stop watching an issue")
+	fmt.Println("  note <watching> <text>   Set the note on a watching")
+}
+
+func printWatchingListUsage() {
+	fmt.Println("Usage: bgl watching list [options]")
+	fmt.Println()
+	fmt.Println("Lists the issues you are watching. Issues updated since you last read")
+	fmt.Println("them are marked with ●.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --unread    Only list issues updated since you last read them")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printWatchingAddUsage() {
+	fmt.Println("Usage: bgl watching add [options] <issueKey>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  issueKey       The issue key (e.g., PROJECT-123) or issue ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --note <text>  Attach a note to the watching")
+	fmt.Println("  --raw          Output raw JSON response")
+	fmt.Println("  -h, --help     Show this help message")
+}
+
+func printWatchingRemoveUsage() {
+	fmt.Println("Usage: bgl watching remove [options] <watching>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  watching    The watching ID, or the key of a watched issue")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  --yes, -y   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printWatchingNoteUsage() {
+	fmt.Println("Usage: bgl watching note [options] <watching> <text>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  watching    The watching ID, or the key of a watched issue")
+	fmt.Println("  text        The note; \"\" clears it")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleStar() {
+	if len(os.Args) < 3 {
+		printStarUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleStarList()
+	case "count":
+		handleStarCount()
+	case "-h", "--help", "help":
+		printStarUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown star command: %s\n", os.Args[2])
+		printStarUsage()
+		os.Exit(1)
+	}
+}
+
+func handleStarList() {
+	// Parse arguments: bgl star list [--raw] [--since <date>] [--limit <n>] [user]
+	args := os.Args[3:]
+
+	opts := star.ListOptions{}
+	var query string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printStarListUsage()
+			return
+		case arg == "--since" || arg == "--limit" || arg == "-n":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printStarListUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--since" {
+				opts.Since = args[i]
+			} else {
+				opts.Limit = parseActivityLimit(args[i])
+			}
+		case strings.HasPrefix(arg, "--since="):
+			opts.Since = strings.TrimPrefix(arg, "--since=")
+		case strings.HasPrefix(arg, "--limit="):
+			opts.Limit = parseActivityLimit(strings.TrimPrefix(arg, "--limit="))
+		default:
+			if query == "" {
+				query = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printStarListUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if query == "" {
+		query = "me"
+	}
+
+	if err := star.List(query, opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleStarCount() {
+	// Parse arguments: bgl star count [--raw] [--since <date>] [--until <date>] [user]
+	args := os.Args[3:]
+
+	opts := star.CountOptions{}
+	var query string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printStarCountUsage()
+			return
+		case arg == "--since" || arg == "--until":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printStarCountUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--since" {
+				opts.Since = args[i]
+			} else {
+				opts.Until = args[i]
+			}
+		case strings.HasPrefix(arg, "--since="):
+			opts.Since = strings.TrimPrefix(arg, "--since=")
+		case strings.HasPrefix(arg, "--until="):
+			opts.Until = strings.TrimPrefix(arg, "--until=")
+		default:
+			if query == "" {
+				query = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printStarCountUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if query == "" {
+		query = "me"
+	}
+
+	if err := star.Count(query, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printStarUsage() {
+	fmt.Println("Usage: bgl star <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] [--since <date>] [--limit <n>] [user]   List stars a user received")
+	fmt.Println("  count [--raw] [--since <date>] [--until <date>] [user]   Count stars a user received")
+}
+
+func printStarListUsage() {
+	fmt.Println("Usage: bgl star list [options] [user]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  user                 me (default), or a user's numeric ID, user ID, name, or mail address")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --since <date>       Only list stars given since a date (yyyy-MM-dd) or period:")
+	fmt.Println("                       today, this-week, last-month, yyyy-Www, yyyy-Qn, yyyy-MM, ...")
+	fmt.Println("  -n, --limit <n>      Number of stars to show (default: 20)")
+	fmt.Println("  --raw                Output raw JSON response")
+	fmt.Println("  -h, --help           Show this help message")
+}
+
+func printStarCountUsage() {
+	fmt.Println("Usage: bgl star count [options] [user]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  user                 me (default), or a user's numeric ID, user ID, name, or mail address")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --since <date>       Count stars given on or after a date (yyyy-MM-dd) or period")
+	fmt.Println("  --until <date>       Count stars given on or before a date (yyyy-MM-dd) or period")
+	fmt.Println("  --raw                Output raw JSON response")
+	fmt.Println("  -h, --help           Show this help message")
+}
+
+func handleTeam() {
+	if len(os.Args) < 3 {
+		printTeamUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleTeamList()
+	case "view":
+		handleTeamView()
+	case "-h", "--help", "help":
+		printTeamUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown team command: %s\n", os.Args[2])
+		printTeamUsage()
+		os.Exit(1)
+	}
+}
+
+func handleTeamList() {
+	// Parse arguments: bgl team list [--raw]
+	args := os.Args[3:]
+
+	opts := team.ListOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printTeamListUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printTeamListUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := team.List(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleTeamView() {
+	// Parse arguments: bgl team view [--raw] <team>
+	args := os.Args[3:]
+
+	opts := team.ViewOptions{}
+	var query string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printTeamViewUsage()
+			return
+		default:
+			if query == "" {
+				query = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printTeamViewUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if query == "" {
+		fmt.Fprintln(os.Stderr, "Error: team is required")
+		printTeamViewUsage()
+		os.Exit(1)
+	}
+
+	if err := team.View(query, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printTeamUsage() {
+	fmt.Println("Usage: bgl team <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw]   List teams in the space")
+	fmt.Println("  view [--raw] <team>   View a team and its members")
+}
+
+func printTeamListUsage() {
+	fmt.Println("Usage: bgl team list [options]")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func printTeamViewUsage() {
+	fmt.Println("Usage: bgl team view [options] <team>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  team        The team name (case-insensitive) or ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleQuick() {
+	// Parse arguments: bgl quick [--raw] [--yes] <line>
+	args := os.Args[2:]
+
+	opts := issue.QuickOptions{ProjectIDOrKey: defaultProject()}
+	var words []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printQuickUsage()
+			return
+		case strings.HasPrefix(arg, "--project="):
+			opts.ProjectIDOrKey = strings.TrimPrefix(arg, "--project=")
+		default:
+			words = append(words, arg)
+		}
+	}
+
+	// The line may be quoted or given as separate words
+	line := strings.Join(words, " ")
+	if strings.TrimSpace(line) == "" {
+		fmt.Fprintln(os.Stderr, "Error: issue line is required")
+		printQuickUsage()
+		os.Exit(1)
+	}
+
+	if err := issue.Quick(line, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printQuickUsage() {
+	fmt.Println("Usage: bgl quick [options] \"PROJ: summary [tokens...]\"")
+	fmt.Println()
+	fmt.Println("Creates an issue from a single line. The parsed fields are shown")
+	fmt.Println("for confirmation before the issue is created.")
+	fmt.Println()
+	fmt.Println("Tokens:")
+	fmt.Println("  PROJ:         Project key (default: --project or the default project)")
+	fmt.Println("  !<priority>   Priority name, e.g. !high (default: Normal)")
+	fmt.Println("  @<user>       Assignee (ID, user ID, name, or mail)")
+	fmt.Println("  due:<date>    Due date: yyyy-MM-dd, today, tomorrow, +<n>d, or a weekday")
+	fmt.Println("  #<category>   Category name (may be repeated)")
+	fmt.Println()
+	fmt.Println("All other words form the summary. The issue type is the project's first.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --project=<projectIdOrKey>   Project used when the line has no prefix")
+	fmt.Println("  --raw                        Output raw JSON response")
+	fmt.Println("  --yes, -y                    Skip confirmation prompt")
+	fmt.Println("  -h, --help                   Show this help message")
+}
+
+// handleComplete serves completion metadata for shell completions and
+// prompts from the local cache. It is not listed in the help.
+func handleComplete() {
+	// Parse arguments: bgl __complete <kind> | --refresh
+	args := os.Args[2:]
+	if len(args) != 1 {
+		fmt.Fprintf(os.Stderr, "Usage: bgl __complete <%s> | --refresh\n", strings.Join(complete.Kinds, "|"))
+		os.Exit(1)
+	}
+
+	if args[0] == "--refresh" {
+		if err := complete.Refresh(); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := complete.Complete(args[0]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func handleNext() {
+	// Parse arguments: bgl next [--raw] [-n <count>]
+	args := os.Args[2:]
+
+	opts := next.Options{Count: 5}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printNextUsage()
+			return
+		case arg == "-n" || strings.HasPrefix(arg, "--count="):
+			value := strings.TrimPrefix(arg, "--count=")
+			if arg == "-n" {
+				if i+1 >= len(args) {
+					fmt.Fprintf(os.Stderr, "Error: %s requires a number\n", arg)
+					printNextUsage()
+					os.Exit(1)
+				}
+				i++
+				value = args[i]
+			}
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				fmt.Fprintln(os.Stderr, "Error: count must be a positive number")
+				printNextUsage()
+				os.Exit(1)
+			}
+			opts.Count = count
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+			printNextUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := next.Next(opts); err != nil {
+		fail(err)
+	}
+}
+
+func printNextUsage() {
+	fmt.Println("Usage: bgl next [options]")
+	fmt.Println()
+	fmt.Println("Ranks your open issues by due date, priority, and staleness.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -n, --count=<n>   Number of issues to show (default: 5)")
+	fmt.Println("  --raw             Output the ranking as JSON")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleRateLimit() {
+	// Parse arguments: bgl ratelimit [--raw]
+	args := os.Args[2:]
+
+	opts := ratelimit.Options{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printRateLimitUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printRateLimitUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := ratelimit.Show(opts); err != nil {
+		fail(err)
+	}
+}
+
+func printRateLimitUsage() {
+	fmt.Println("Usage: bgl ratelimit [options]")
+	fmt.Println()
+	fmt.Println("Shows how many read, update, search, and icon API requests you have left")
+	fmt.Println("and when each quota resets.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleQueue() {
+	if len(os.Args) < 3 {
+		printQueueUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleQueueList()
+	case "flush":
+		handleQueueFlush()
+	case "-h", "--help", "help":
+		printQueueUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown queue command: %s\n", os.Args[2])
+		printQueueUsage()
+		os.Exit(1)
+	}
+}
+
+func handleQueueList() {
+	// Parse arguments: bgl queue list [--raw]
+	args := os.Args[3:]
+
+	opts := queue.ListOptions{}
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printQueueUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printQueueUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := queue.List(opts); err != nil {
+		fail(err)
+	}
+}
+
+func handleQueueFlush() {
+	// Parse arguments: bgl queue flush
+	args := os.Args[3:]
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "-h", "--help":
+			printQueueUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printQueueUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := queue.Flush(); err != nil {
+		fail(err)
+	}
+}
+
+func printQueueUsage() {
+	fmt.Println("Usage: bgl queue <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw]   List comments queued while offline")
+	fmt.Println("  flush          Post queued comments in order")
+}
+
+func handleStats() {
+	// Parse arguments: bgl stats --self [--raw] [--enable|--disable|--reset]
+	args := os.Args[2:]
+
+	opts := usage.Options{}
+	self := false
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--self":
+			self = true
+		case "--raw":
+			opts.Raw = true
+		case "--enable":
+			opts.Enable = true
+		case "--disable":
+			opts.Disable = true
+		case "--reset":
+			opts.Reset = true
+		case "-h", "--help":
+			printStatsUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+			printStatsUsage()
+			os.Exit(1)
+		}
+	}
+
+	if !self {
+		fmt.Fprintln(os.Stderr, "Error: --self is required")
+		printStatsUsage()
+		os.Exit(1)
+	}
+
+	if err := usage.Show(opts); err != nil {
+		fail(err)
+	}
+}
+
+func printStatsUsage() {
+	fmt.Println("Usage: bgl stats --self [options]")
+	fmt.Println()
+	fmt.Println("Shows local usage counters: how often each command ran and error")
+	fmt.Println("categories. Counting is opt-in, and nothing is sent over the network.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --enable    Turn on usage counting")
+	fmt.Println("  --disable   Turn off usage counting")
+	fmt.Println("  --reset     Delete the recorded counters")
+	fmt.Println("  --raw       Output the counters as JSON")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleBugreport() {
+	// Parse arguments: bgl bugreport [-o <path>] [--log-lines=<n>] [-- <command>...]
+	args := os.Args[2:]
+
+	opts := bugreport.Options{LogLines: bugreport.DefaultLogLines}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			opts.Command = args[i+1:]
+			i = len(args)
+		case arg == "-o" || arg == "--output":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a path\n", arg)
+				printBugreportUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Output = args[i]
+		case strings.HasPrefix(arg, "--output="):
+			opts.Output = strings.TrimPrefix(arg, "--output=")
+		case strings.HasPrefix(arg, "--log-lines="):
+			n, err := strconv.Atoi(strings.TrimPrefix(arg, "--log-lines="))
+			if err != nil || n < 0 {
+				fmt.Fprintln(os.Stderr, "Error: --log-lines must be a non-negative number")
+				printBugreportUsage()
+				os.Exit(1)
+			}
+			opts.LogLines = n
+		case arg == "-h" || arg == "--help":
+			printBugreportUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+			printBugreportUsage()
+			os.Exit(1)
+		}
+	}
+
+	build := bugreport.BuildInfo{Version: version, Commit: commit, Date: date}
+	if err := bugreport.Generate(build, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printBugreportUsage() {
+	fmt.Println("Usage: bgl bugreport [options] [-- <command>...]")
+	fmt.Println()
+	fmt.Println("Writes version, OS, a redacted config summary, and the most recent API")
+	fmt.Println("requests to a file. If a command is given after --, it is re-run with")
+	fmt.Println("BGL_DEBUG=1 and its output is included as a trace.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -o, --output=<path>   Write the report to the given path (default: bgl-bugreport-<time>.md)")
+	fmt.Println("  --log-lines=<n>       Number of request log entries to include (default: 50, 0 to omit)")
+	fmt.Println("  -h, --help            Show this help message")
+}
+
+func handleWebhook() {
+	if len(os.Args) < 3 {
+		printWebhookUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleWebhookList()
+	case "add":
+		handleWebhookAdd()
+	case "delete":
+		handleWebhookDelete()
+	case "-h", "--help", "help":
+		printWebhookUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown webhook command: %s\n", os.Args[2])
+		printWebhookUsage()
+		os.Exit(1)
+	}
+}
+
+func printWebhookUsage() {
+	fmt.Println("Usage: bgl webhook <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] <projectId>   List webhooks for a project")
+	fmt.Println("  add [options] <projectId>  Add a webhook")
+	fmt.Println("  delete [options] <projectId> <webhook>")
+	fmt.Println("                             Delete a webhook")
+}
+
+func handleWebhookList() {
+	// Parse arguments: bgl webhook list [--raw] <projectId>
+	args := os.Args[3:]
+
+	opts := webhook.ListOptions{}
+	var projectID string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printWebhookListUsage()
+			return
+		default:
+			if projectID == "" {
+				projectID = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printWebhookListUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectID == "" {
+		projectID = defaultProject()
+	}
+
+	if projectID == "" {
+		fmt.Fprintln(os.Stderr, "Error: project ID is required")
+		printWebhookListUsage()
+		os.Exit(1)
+	}
+
+	if err := webhook.List(projectID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWebhookListUsage() {
+	fmt.Println("Usage: bgl webhook list [options] <projectId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleWebhookAdd() {
+	// Parse arguments: bgl webhook add [--raw] [--yes] --name=<name> --url=<url> [--description=<text>] [--events=<types>] <projectId>
+	args := os.Args[3:]
+
+	opts := webhook.AddOptions{}
+	var projectID string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printWebhookAddUsage()
+			return
+		case strings.HasPrefix(arg, "--name="):
+			opts.Name = strings.TrimPrefix(arg, "--name=")
+		case strings.HasPrefix(arg, "--url="):
+			opts.URL = strings.TrimPrefix(arg, "--url=")
+		case strings.HasPrefix(arg, "--description="):
+			opts.Description = strings.TrimPrefix(arg, "--description=")
+		case strings.HasPrefix(arg, "--events="):
+			opts.Events = strings.TrimPrefix(arg, "--events=")
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printWebhookAddUsage()
+			os.Exit(1)
+		default:
+			if projectID == "" {
+				projectID = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printWebhookAddUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectID == "" {
+		projectID = defaultProject()
+	}
+
+	if projectID == "" || opts.Name == "" || opts.URL == "" {
+		fmt.Fprintln(os.Stderr, "Error: project ID, --name and --url are required")
+		printWebhookAddUsage()
+		os.Exit(1)
+	}
+
+	if err := webhook.Add(projectID, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWebhookAddUsage() {
+	fmt.Println("Usage: bgl webhook add [options] <projectId>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId             The project ID or project key (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --name=<name>         Webhook name (required)")
+	fmt.Println("  --url=<url>           URL to call (required)")
+	fmt.Println("  --description=<text>  Description")
+	fmt.Println("  --events=<types>      Comma-separated activity types to send (default: all events),")
+	fmt.Println("                        e.g. issue-created,issue-updated,issue-commented")
+	fmt.Println("  --raw                 Output raw JSON response")
+	fmt.Println("  -y, --yes             Skip confirmation prompt")
+	fmt.Println("  -h, --help            Show this help message")
+	fmt.Println()
+	fmt.Println("Activity types:")
+	webhook.PrintActivityTypes()
+}
+
+func handleWebhookDelete() {
+	// Parse arguments: bgl webhook delete [--raw] [--yes] [projectId] <webhook>
+	args := os.Args[3:]
+
+	opts := webhook.DeleteOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printWebhookDeleteUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	projectID, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project ID and webhook are required")
+		printWebhookDeleteUsage()
+		os.Exit(1)
+	}
+
+	if err := webhook.Delete(projectID, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWebhookDeleteUsage() {
+	fmt.Println("Usage: bgl webhook delete [options] [projectId] <webhook>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectId   The project ID or project key (default: the default project)")
+	fmt.Println("  webhook     The webhook ID or name")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -y, --yes   Skip confirmation prompt")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleFile() {
+	if len(os.Args) < 3 {
+		printFileUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleFileList()
+	case "download":
+		handleFileDownload()
+	case "-h", "--help", "help":
+		printFileUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown file command: %s\n", os.Args[2])
+		printFileUsage()
+		os.Exit(1)
+	}
+}
+
+func printFileUsage() {
+	fmt.Println("Usage: bgl file <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] <projectKey> [path]          List shared files in a directory")
+	fmt.Println("  download [-d <dir>] <projectKey> <path>   Download a shared file")
+}
+
+func handleFileList() {
+	// Parse arguments: bgl file list [--raw] <projectKey> [path]
+	args := os.Args[3:]
+
+	opts := file.ListOptions{}
+	var projectKey string
+	dir := "/"
+	dirSet := false
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printFileListUsage()
+			return
+		default:
+			if projectKey == "" {
+				projectKey = args[i]
+			} else if !dirSet {
+				dir = args[i]
+				dirSet = true
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printFileListUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key is required")
+		printFileListUsage()
+		os.Exit(1)
+	}
+
+	if err := file.List(projectKey, dir, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printFileListUsage() {
+	fmt.Println("Usage: bgl file list [options] <projectKey> [path]")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey  The project key or ID (default: the default project)")
+	fmt.Println("  path        The directory to list (default: /)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handleFileDownload() {
+	// Parse arguments: bgl file download [-d <dir>] [projectKey] <path>
+	args := os.Args[3:]
+
+	opts := file.DownloadOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "-d" || arg == "--dir":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a path\n", arg)
+				printFileDownloadUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Dir = args[i]
+		case strings.HasPrefix(arg, "--dir="):
+			opts.Dir = strings.TrimPrefix(arg, "--dir=")
+		case arg == "-h" || arg == "--help":
+			printFileDownloadUsage()
+			return
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key and file path are required")
+		printFileDownloadUsage()
+		os.Exit(1)
+	}
+
+	if err := file.Download(projectKey, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printFileDownloadUsage() {
+	fmt.Println("Usage: bgl file download [options] [projectKey] <path>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey        The project key or ID (default: the default project)")
+	fmt.Println("  path              The file's path in the project's file storage")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -d, --dir <dir>   Directory to save to (default: current directory)")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWiki() {
+	if len(os.Args) < 3 {
+		printWikiUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleWikiList()
+	case "view":
+		handleWikiView()
+	case "create":
+		handleWikiCreate()
+	case "edit":
+		handleWikiEdit()
+	case "delete":
+		handleWikiDelete()
+	case "history":
+		handleWikiHistory()
+	case "diff":
+		handleWikiDiff()
+	case "attach":
+		handleWikiAttach()
+	case "attachments":
+		handleWikiAttachments()
+	case "tags":
+		handleWikiTags()
+	case "search":
+		handleWikiSearch()
+	case "export":
+		handleWikiExport()
+	case "sync":
+		handleWikiSync()
+	case "-h", "--help", "help":
+		printWikiUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown wiki command: %s\n", os.Args[2])
+		printWikiUsage()
+		os.Exit(1)
+	}
+}
+
+func printWikiUsage() {
+	fmt.Println("Usage: bgl wiki <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] [--keyword=<text>] <projectKey>   List wiki pages")
+	fmt.Println("  view [--raw] <wikiId|PROJ:PageName>   View a wiki page")
+	fmt.Println("  create [--yes] --body-file=<path> [--tag=<tag>] [projectKey] <name>   Create a wiki page")
+	fmt.Println("  edit [--yes] [--name=<name>] [--tag=<tag>] [--untag=<tag>] <wikiId|PROJ:PageName>   Edit a wiki page in your editor")
+	fmt.Println("  delete [--yes] [--mail-notify] <wikiId|PROJ:PageName>   Delete a wiki page")
+	fmt.Println("  history [--raw] <wikiId|PROJ:PageName>   List a wiki page's revisions")
+	fmt.Println("  diff <wikiId|PROJ:PageName> <v1> <v2>   Show changes between two revisions")
+	fmt.Println("  attach [--yes] <wikiId|PROJ:PageName> <file>...   Attach files to a wiki page")
+	fmt.Println("  attachments [--raw] [--download [--dir <dir>]] <wikiId|PROJ:PageName>   List or download a wiki page's attachments")
+	fmt.Println("  tags [--raw] <projectKey>   List wiki tags")
+	fmt.Println("  search [--raw] <projectKey> <keyword>   Search wiki pages")
+	fmt.Println("  export [--attachments] <projectKey> <dir>   Download all wiki pages as Markdown files")
+	fmt.Println("  sync [--yes] [--force] [--dry-run] <dir>   Push local edits of an export back to the wiki")
+}
+
+func handleWikiList() {
+	// Parse arguments: bgl wiki list [--raw] [--keyword=<text>] <projectKey>
+	args := os.Args[3:]
+
+	opts := wiki.ListOptions{}
+	var projectKey string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printWikiListUsage()
+			return
+		case arg == "--keyword":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printWikiListUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Keyword = args[i]
+		case strings.HasPrefix(arg, "--keyword="):
+			opts.Keyword = strings.TrimPrefix(arg, "--keyword=")
+		default:
+			if projectKey == "" {
+				projectKey = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printWikiListUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key is required")
+		printWikiListUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.List(projectKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiListUsage() {
+	fmt.Println("Usage: bgl wiki list [options] <projectKey>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey        The project key or ID (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --keyword=<text>  Only list pages whose name or content matches")
+	fmt.Println("  --raw             Output raw JSON response")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiView() {
+	// Parse arguments: bgl wiki view [--raw] <wikiId|PROJ:PageName>
+	args := os.Args[3:]
+
+	opts := wiki.ViewOptions{}
+	var page string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printWikiViewUsage()
+			return
+		default:
+			if page == "" {
+				page = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printWikiViewUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if page == "" {
+		fmt.Fprintln(os.Stderr, "Error: wiki page is required")
+		printWikiViewUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.View(page, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiViewUsage() {
+	fmt.Println("Usage: bgl wiki view [options] <wikiId|PROJ:PageName>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  wikiId            The wiki page ID")
+	fmt.Println("  PROJ:PageName     A project key and page name (e.g., PROJ:Home)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw             Output raw JSON response")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiCreate() {
+	// Parse arguments: bgl wiki create [--raw] [--yes] [--mail-notify] --body-file=<path> [--tag=<tag>]... [projectKey] <name>
+	args := os.Args[3:]
+
+	opts := wiki.CreateOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "--mail-notify":
+			opts.MailNotify = true
+		case arg == "-h" || arg == "--help":
+			printWikiCreateUsage()
+			return
+		case arg == "--body-file" || arg == "--tag":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printWikiCreateUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--body-file" {
+				opts.BodyFile = args[i]
+			} else {
+				opts.Tags = append(opts.Tags, args[i])
+			}
+		case strings.HasPrefix(arg, "--body-file="):
+			opts.BodyFile = strings.TrimPrefix(arg, "--body-file=")
+		case strings.HasPrefix(arg, "--tag="):
+			opts.Tags = append(opts.Tags, strings.TrimPrefix(arg, "--tag="))
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printWikiCreateUsage()
+			os.Exit(1)
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key and page name are required")
+		printWikiCreateUsage()
+		os.Exit(1)
+	}
+
+	if opts.BodyFile == "" {
+		fmt.Fprintln(os.Stderr, "Error: --body-file is required")
+		printWikiCreateUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.Create(projectKey, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiCreateUsage() {
+	fmt.Println("Usage: bgl wiki create [options] [projectKey] <name>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey            The project key or ID (default: the default project)")
+	fmt.Println("  name                  The page name")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --body-file=<path>    Read the page content from a file (- for stdin)")
+	fmt.Println("  --tag=<tag>           Tag the page (may be repeated)")
+	fmt.Println("  --mail-notify         Notify project members by mail")
+	fmt.Println("  --raw                 Output raw JSON response")
+	fmt.Println("  -y, --yes             Skip confirmation prompt")
+	fmt.Println("  -h, --help            Show this help message")
+}
+
+func handleWikiEdit() {
+	// Parse arguments: bgl wiki edit [--raw] [--yes] [--force] [--mail-notify] [--name=<name>] [--tag=<tag>]... [--untag=<tag>]... <wikiId|PROJ:PageName>
+	args := os.Args[3:]
+
+	opts := wiki.EditOptions{}
+	var page string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "--force":
+			opts.Force = true
+		case arg == "--mail-notify":
+			opts.MailNotify = true
+		case arg == "-h" || arg == "--help":
+			printWikiEditUsage()
+			return
+		case arg == "--name":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printWikiEditUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Name = args[i]
+		case strings.HasPrefix(arg, "--name="):
+			opts.Name = strings.TrimPrefix(arg, "--name=")
+		case arg == "--tag" || arg == "--untag":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printWikiEditUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--tag" {
+				opts.Tags = append(opts.Tags, args[i])
+			} else {
+				opts.Untags = append(opts.Untags, args[i])
+			}
+		case strings.HasPrefix(arg, "--tag="):
+			opts.Tags = append(opts.Tags, strings.TrimPrefix(arg, "--tag="))
+		case strings.HasPrefix(arg, "--untag="):
+			opts.Untags = append(opts.Untags, strings.TrimPrefix(arg, "--untag="))
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printWikiEditUsage()
+			os.Exit(1)
+		default:
+			if page == "" {
+				page = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printWikiEditUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if page == "" {
+		fmt.Fprintln(os.Stderr, "Error: wiki page is required")
+		printWikiEditUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.Edit(page, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiEditUsage() {
+	fmt.Println("Usage: bgl wiki edit [options] <wikiId|PROJ:PageName>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  wikiId            The wiki page ID")
+	fmt.Println("  PROJ:PageName     A project key and page name (e.g., PROJ:Home)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --name=<name>     Rename the page")
+	fmt.Println("  --tag=<tag>       Add a tag without opening the editor (may be repeated)")
+	fmt.Println("  --untag=<tag>     Remove a tag without opening the editor (may be repeated)")
+	fmt.Println("  --mail-notify     Notify project members by mail")
+	fmt.Println("  --force           Overwrite without asking if the page changed remotely")
+	fmt.Println("  --raw             Output raw JSON response")
+	fmt.Println("  -y, --yes         Skip confirmation prompt")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiDelete() {
+	// Parse arguments: bgl wiki delete [--raw] [--yes] [--mail-notify] <wikiId|PROJ:PageName>
+	args := os.Args[3:]
+
+	opts := wiki.DeleteOptions{}
+	var page string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "--mail-notify":
+			opts.MailNotify = true
+		case "-h", "--help":
+			printWikiDeleteUsage()
+			return
+		default:
+			if page == "" {
+				page = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printWikiDeleteUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if page == "" {
+		fmt.Fprintln(os.Stderr, "Error: wiki page is required")
+		printWikiDeleteUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.Delete(page, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiDeleteUsage() {
+	fmt.Println("Usage: bgl wiki delete [options] <wikiId|PROJ:PageName>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  wikiId            The wiki page ID")
+	fmt.Println("  PROJ:PageName     A project key and page name (e.g., PROJ:Home)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --mail-notify     Notify project members by mail")
+	fmt.Println("  --raw             Output raw JSON response")
+	fmt.Println("  -y, --yes         Skip confirmation prompt")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiHistory() {
+	// Parse arguments: bgl wiki history [--raw] <wikiId|PROJ:PageName>
+	args := os.Args[3:]
+
+	opts := wiki.HistoryOptions{}
+	var page string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printWikiHistoryUsage()
+			return
+		default:
+			if page == "" {
+				page = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printWikiHistoryUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if page == "" {
+		fmt.Fprintln(os.Stderr, "Error: wiki page is required")
+		printWikiHistoryUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.History(page, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiHistoryUsage() {
+	fmt.Println("Usage: bgl wiki history [options] <wikiId|PROJ:PageName>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  wikiId            The wiki page ID")
+	fmt.Println("  PROJ:PageName     A project key and page name (e.g., PROJ:Home)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw             Output the revisions as JSON")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiDiff() {
+	// Parse arguments: bgl wiki diff <wikiId|PROJ:PageName> <v1> <v2>
+	args := os.Args[3:]
+
+	var positional []string
+	for _, arg := range args {
+		switch arg {
+		case "-h", "--help":
+			printWikiDiffUsage()
+			return
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	if len(positional) != 3 {
+		fmt.Fprintln(os.Stderr, "Error: wiki page and two versions are required")
+		printWikiDiffUsage()
+		os.Exit(1)
+	}
+
+	oldVersion, err := strconv.Atoi(strings.TrimPrefix(positional[1], "v"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid version: %s\n", positional[1])
+		os.Exit(1)
+	}
+	newVersion, err := strconv.Atoi(strings.TrimPrefix(positional[2], "v"))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid version: %s\n", positional[2])
+		os.Exit(1)
+	}
+
+	if err := wiki.Diff(positional[0], oldVersion, newVersion); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiDiffUsage() {
+	fmt.Println("Usage: bgl wiki diff <wikiId|PROJ:PageName> <v1> <v2>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  wikiId            The wiki page ID")
+	fmt.Println("  PROJ:PageName     A project key and page name (e.g., PROJ:Home)")
+	fmt.Println("  v1, v2            The versions to compare (see 'bgl wiki history')")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiAttach() {
+	// Parse arguments: bgl wiki attach [--raw] [--yes] <wikiId|PROJ:PageName> <file>...
+	args := os.Args[3:]
+
+	opts := wiki.AttachOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--yes", "-y":
+			opts.Yes = true
+		case "-h", "--help":
+			printWikiAttachUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	if len(positional) < 2 {
+		fmt.Fprintln(os.Stderr, "Error: wiki page and at least one file are required")
+		printWikiAttachUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.Attach(positional[0], positional[1:], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiAttachUsage() {
+	fmt.Println("Usage: bgl wiki attach [options] <wikiId|PROJ:PageName> <file>...")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  wikiId            The wiki page ID")
+	fmt.Println("  PROJ:PageName     A project key and page name (e.g., PROJ:Home)")
+	fmt.Println("  file              Files to attach")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw             Output raw JSON response")
+	fmt.Println("  -y, --yes         Skip confirmation prompt")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiAttachments() {
+	// Parse arguments: bgl wiki attachments [--raw] [--download] [--dir <dir>] <wikiId|PROJ:PageName>
+	args := os.Args[3:]
+
+	opts := wiki.AttachmentsOptions{}
+	var page string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--download":
+			opts.Download = true
+		case arg == "-d" || arg == "--dir":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a path\n", arg)
+				printWikiAttachmentsUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Dir = args[i]
+		case strings.HasPrefix(arg, "--dir="):
+			opts.Dir = strings.TrimPrefix(arg, "--dir=")
+		case arg == "-h" || arg == "--help":
+			printWikiAttachmentsUsage()
+			return
+		default:
+			if page == "" {
+				page = arg
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+				printWikiAttachmentsUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if page == "" {
+		fmt.Fprintln(os.Stderr, "Error: wiki page is required")
+		printWikiAttachmentsUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.Attachments(page, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiAttachmentsUsage() {
+	fmt.Println("Usage: bgl wiki attachments [options] <wikiId|PROJ:PageName>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  wikiId            The wiki page ID")
+	fmt.Println("  PROJ:PageName     A project key and page name (e.g., PROJ:Home)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --download        Download all attachments instead of listing them")
+	fmt.Println("  -d, --dir <dir>   Directory to save to (default: current directory)")
+	fmt.Println("  --raw             Output raw JSON response")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiTags() {
+	// Parse arguments: bgl wiki tags [--raw] <projectKey>
+	args := os.Args[3:]
+
+	opts := wiki.TagsOptions{}
+	var projectKey string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printWikiTagsUsage()
+			return
+		default:
+			if projectKey == "" {
+				projectKey = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printWikiTagsUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key is required")
+		printWikiTagsUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.Tags(projectKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiTagsUsage() {
+	fmt.Println("Usage: bgl wiki tags [options] <projectKey>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey        The project key or ID (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw             Output raw JSON response")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiSearch() {
+	// Parse arguments: bgl wiki search [--raw] [projectKey] <keyword>
+	args := os.Args[3:]
+
+	opts := wiki.SearchOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printWikiSearchUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key and keyword are required")
+		printWikiSearchUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.Search(projectKey, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiSearchUsage() {
+	fmt.Println("Usage: bgl wiki search [options] [projectKey] <keyword>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey        The project key or ID (default: the default project)")
+	fmt.Println("  keyword           Text to find in page names and content")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw             Output the results as JSON")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiExport() {
+	// Parse arguments: bgl wiki export [--attachments] [projectKey] <dir>
+	args := os.Args[3:]
+
+	opts := wiki.ExportOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--attachments":
+			opts.Attachments = true
+		case "-h", "--help":
+			printWikiExportUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key and directory are required")
+		printWikiExportUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.Export(projectKey, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiExportUsage() {
+	fmt.Println("Usage: bgl wiki export [options] [projectKey] <dir>")
+	fmt.Println()
+	fmt.Println("Saves each wiki page as a Markdown file and writes a .bgl-wiki.json")
+	fmt.Println("manifest used by 'bgl wiki sync'.")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey        The project key or ID (default: the default project)")
+	fmt.Println("  dir               Directory to save to")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --attachments     Also download each page's attachments")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleWikiSync() {
+	// Parse arguments: bgl wiki sync [--yes] [--force] [--dry-run] <dir>
+	args := os.Args[3:]
+
+	opts := wiki.SyncOptions{}
+	var dir string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--yes", "-y":
+			opts.Yes = true
+		case "--force":
+			opts.Force = true
+		case "--dry-run":
+			opts.DryRun = true
+		case "-h", "--help":
+			printWikiSyncUsage()
+			return
+		default:
+			if dir == "" {
+				dir = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printWikiSyncUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if dir == "" {
+		fmt.Fprintln(os.Stderr, "Error: directory is required")
+		printWikiSyncUsage()
+		os.Exit(1)
+	}
+
+	if err := wiki.Sync(dir, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printWikiSyncUsage() {
+	fmt.Println("Usage: bgl wiki sync [options] <dir>")
+	fmt.Println()
+	fmt.Println("Pushes files changed since 'bgl wiki export' back to their pages.")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  dir               A directory written by 'bgl wiki export'")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --dry-run         Only show which pages would be pushed")
+	fmt.Println("  --force           Overwrite pages that also changed remotely")
+	fmt.Println("  -y, --yes         Skip confirmation prompt")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleRepo() {
+	if len(os.Args) < 3 {
+		printRepoUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handleRepoList()
+	case "view":
+		handleRepoView()
+	case "-h", "--help", "help":
+		printRepoUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown repo command: %s\n", os.Args[2])
+		printRepoUsage()
+		os.Exit(1)
+	}
+}
+
+func printRepoUsage() {
+	fmt.Println("Usage: bgl repo <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] <projectKey>   List Git repositories")
+	fmt.Println("  view [--raw] [--clone] <projectKey> <repo>   View or clone a Git repository")
+}
+
+func handleRepoList() {
+	// Parse arguments: bgl repo list [--raw] <projectKey>
+	args := os.Args[3:]
+
+	opts := repo.ListOptions{}
+	var projectKey string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printRepoListUsage()
+			return
+		default:
+			if projectKey == "" {
+				projectKey = args[i]
+			} else {
+				fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", args[i])
+				printRepoListUsage()
+				os.Exit(1)
+			}
+		}
+	}
+
+	if projectKey == "" {
+		projectKey = defaultProject()
+	}
+
+	if projectKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: project key is required")
+		printRepoListUsage()
+		os.Exit(1)
+	}
+
+	if err := repo.List(projectKey, opts); err != nil {
+		fail(err)
+	}
+}
+
+func printRepoListUsage() {
+	fmt.Println("Usage: bgl repo list [options] <projectKey>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey        The project key or ID (default: the default project)")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw             Output raw JSON response")
+	fmt.Println("  -h, --help        Show this help message")
+}
+
+func handleRepoView() {
+	// Parse arguments: bgl repo view [--raw] [--clone] [--protocol=<ssh|https>] [projectKey] <repo>
+	args := os.Args[3:]
+
+	opts := repo.ViewOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--clone":
+			opts.Clone = true
+		case arg == "--protocol":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printRepoViewUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Protocol = args[i]
+		case strings.HasPrefix(arg, "--protocol="):
+			opts.Protocol = strings.TrimPrefix(arg, "--protocol=")
+		case arg == "-h" || arg == "--help":
+			printRepoViewUsage()
+			return
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key and repository are required")
+		printRepoViewUsage()
+		os.Exit(1)
+	}
+
+	if err := repo.View(projectKey, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printRepoViewUsage() {
+	fmt.Println("Usage: bgl repo view [options] [projectKey] <repo>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey              The project key or ID (default: the default project)")
+	fmt.Println("  repo                    The repository name or ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --clone                 Clone the repository into the current directory")
+	fmt.Println("  --protocol=<ssh|https>  Clone URL to use (default: git_protocol config, or ssh)")
+	fmt.Println("  --raw                   Output raw JSON response")
+	fmt.Println("  -h, --help              Show this help message")
+}
+
+func handlePR() {
+	if len(os.Args) < 3 {
+		printPRUsage()
+		os.Exit(1)
+	}
+
+	switch os.Args[2] {
+	case "list":
+		handlePRList()
+	case "view":
+		handlePRView()
+	case "create":
+		handlePRCreate()
+	case "edit":
+		handlePREdit()
+	case "attachments":
+		handlePRAttachments()
+	case "count":
+		handlePRCount()
+	case "checkout":
+		handlePRCheckout()
+	case "status":
+		handlePRStatus()
+	case "browse":
+		handlePRBrowse()
+	case "-h", "--help", "help":
+		printPRUsage()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown pr command: %s\n", os.Args[2])
+		printPRUsage()
+		os.Exit(1)
+	}
+}
+
+func printPRUsage() {
+	fmt.Println("Usage: bgl pr <command>")
+	fmt.Println()
+	fmt.Println("Commands:")
+	fmt.Println("  list [--raw] [--status <status>] [--assignee <user>] <projectKey> <repo>   List pull requests")
+	fmt.Println("  view [--raw] [--web] <projectKey> <repo> <number>   View a pull request")
+	fmt.Println("  browse <projectKey> <repo> <number>   Open a pull request in the browser")
+	fmt.Println("  create [--yes] --base <branch> --title <title> [options] <projectKey> <repo>   Create a pull request")
+	fmt.Println("  edit [--yes] [options] <projectKey> <repo> <number>   Edit a pull request")
+	fmt.Println("  attachments [--raw] [--download [--dir <dir>]] <projectKey> <repo> <number>   List or download a pull request's attachments")
+	fmt.Println("  count [--raw] [--status <status>] [--assignee <user>] <projectKey> <repo>   Count pull requests")
+	fmt.Println("  checkout <number>   Check out a pull request's branch in the current clone")
+	fmt.Println("  status [--raw]   Show the open pull request from the current branch")
+}
+
+func handlePRList() {
+	// Parse arguments: bgl pr list [--raw] [--status <status>] [--assignee <user>] [projectKey] <repo>
+	args := os.Args[3:]
+
+	opts := pr.ListOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printPRListUsage()
+			return
+		case arg == "--status" || arg == "--assignee":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printPRListUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--status" {
+				opts.Status = args[i]
+			} else {
+				opts.Assignee = args[i]
+			}
+		case strings.HasPrefix(arg, "--status="):
+			opts.Status = strings.TrimPrefix(arg, "--status=")
+		case strings.HasPrefix(arg, "--assignee="):
+			opts.Assignee = strings.TrimPrefix(arg, "--assignee=")
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printPRListUsage()
+			os.Exit(1)
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key and repository are required")
+		printPRListUsage()
+		os.Exit(1)
+	}
+
+	if err := pr.List(projectKey, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printPRListUsage() {
+	fmt.Println("Usage: bgl pr list [options] [projectKey] <repo>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey          The project key or ID (default: the default project)")
+	fmt.Println("  repo                The repository name or ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --status <status>   Only list open, closed, or merged pull requests")
+	fmt.Println("  --assignee <user>   Only list pull requests assigned to a user (me, ID, name, or mail)")
+	fmt.Println("  --raw               Output raw JSON response")
+	fmt.Println("  -h, --help          Show this help message")
+}
+
+func handlePRView() {
+	// Parse arguments: bgl pr view [--raw] [--web] [projectKey] <repo> <number>
+	args := os.Args[3:]
+
+	opts := pr.ViewOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "--raw":
+			opts.Raw = true
+		case "--web", "-w":
+			opts.Web = true
+		case "-h", "--help":
+			printPRViewUsage()
+			return
+		default:
+			positional = append(positional, args[i])
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 2)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key, repository, and pull request number are required")
+		printPRViewUsage()
+		os.Exit(1)
+	}
+
+	if err := pr.View(projectKey, rest[0], rest[1], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printPRViewUsage() {
+	fmt.Println("Usage: bgl pr view [options] [projectKey] <repo> <number>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey          The project key or ID (default: the default project)")
+	fmt.Println("  repo                The repository name or ID")
+	fmt.Println("  number              The pull request number")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw               Output raw JSON response")
+	fmt.Println("  --web, -w           Open the pull request in the browser")
+	fmt.Println("  -h, --help          Show this help message")
+}
+
+func handlePRCreate() {
+	// Parse arguments: bgl pr create [--raw] [--yes] --base <branch> [--branch <branch>] --title <title> [--description <text>] [--body-file <path>] [--issue <issueKey>] [--assignee <user>] [projectKey] <repo>
+	args := os.Args[3:]
+
+	opts := pr.CreateOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printPRCreateUsage()
+			return
+		case arg == "--base" || arg == "--branch" || arg == "--title" || arg == "--description" ||
+			arg == "--body-file" || arg == "--issue" || arg == "--assignee":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printPRCreateUsage()
+				os.Exit(1)
+			}
+			i++
+			setPRCreateOption(&opts, arg, args[i])
+		case strings.HasPrefix(arg, "--") && strings.Contains(arg, "="):
+			name, value, _ := strings.Cut(arg, "=")
+			if !setPRCreateOption(&opts, name, value) {
+				fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+				printPRCreateUsage()
+				os.Exit(1)
+			}
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printPRCreateUsage()
+			os.Exit(1)
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key and repository are required")
+		printPRCreateUsage()
+		os.Exit(1)
+	}
+
+	if err := pr.Create(projectKey, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+// setPRCreateOption sets a 'pr create' option that takes a value,
+// reporting whether name is such an option.
+func setPRCreateOption(opts *pr.CreateOptions, name string, value string) bool {
+	switch name {
+	case "--base":
+		opts.Base = value
+	case "--branch":
+		opts.Branch = value
+	case "--title":
+		opts.Title = value
+	case "--description":
+		opts.Description = value
+	case "--body-file":
+		opts.BodyFile = value
+	case "--issue":
+		opts.Issue = value
+	case "--assignee":
+		opts.Assignee = value
+	default:
+		return false
+	}
+	return true
+}
+
+func printPRCreateUsage() {
+	fmt.Println("Usage: bgl pr create [options] [projectKey] <repo>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey            The project key or ID (default: the default project)")
+	fmt.Println("  repo                  The repository name or ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --base <branch>       Branch to merge into (required)")
+	fmt.Println("  --branch <branch>     Branch to merge from (default: the current git branch)")
+	fmt.Println("  --title <title>       Pull request title (required)")
+	fmt.Println("  --description <text>  Pull request description")
+	fmt.Println("  --body-file <path>    Read the description from a file (- for stdin)")
+	fmt.Println("  --issue <issueKey>    Link an issue (e.g., PROJECT-123)")
+	fmt.Println("  --assignee <user>     Assign a user (me, ID, name, or mail)")
+	fmt.Println("  --raw                 Output raw JSON response")
+	fmt.Println("  -y, --yes             Skip confirmation prompt")
+	fmt.Println("  -h, --help            Show this help message")
+}
+
+func handlePREdit() {
+	// Parse arguments: bgl pr edit [--raw] [--yes] [--title <title>] [--description <text>] [--body-file <path>] [--assignee <user>] [--issue <issueKey>] [--comment <text>] [projectKey] <repo> <number>
+	args := os.Args[3:]
+
+	opts := pr.EditOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--yes" || arg == "-y":
+			opts.Yes = true
+		case arg == "-h" || arg == "--help":
+			printPREditUsage()
+			return
+		case arg == "--title" || arg == "--description" || arg == "--body-file" ||
+			arg == "--assignee" || arg == "--issue" || arg == "--comment":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printPREditUsage()
+				os.Exit(1)
+			}
+			i++
+			setPREditOption(&opts, arg, args[i])
+		case strings.HasPrefix(arg, "--") && strings.Contains(arg, "="):
+			name, value, _ := strings.Cut(arg, "=")
+			if !setPREditOption(&opts, name, value) {
+				fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+				printPREditUsage()
+				os.Exit(1)
+			}
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printPREditUsage()
+			os.Exit(1)
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 2)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key, repository, and pull request number are required")
+		printPREditUsage()
+		os.Exit(1)
+	}
+
+	if err := pr.Edit(projectKey, rest[0], rest[1], opts); err != nil {
+		fail(err)
+	}
+}
+
+// setPREditOption sets a 'pr edit' option that takes a value, reporting
+// whether name is such an option.
+func setPREditOption(opts *pr.EditOptions, name string, value string) bool {
+	switch name {
+	case "--title":
+		opts.Title = value
+	case "--description":
+		opts.Description = value
+	case "--body-file":
+		opts.BodyFile = value
+	case "--assignee":
+		opts.Assignee = value
+	case "--issue":
+		opts.Issue = value
+	case "--comment":
+		opts.Comment = value
+	default:
+		return false
+	}
+	return true
+}
+
+func printPREditUsage() {
+	fmt.Println("Usage: bgl pr edit [options] [projectKey] <repo> <number>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey            The project key or ID (default: the default project)")
+	fmt.Println("  repo                  The repository name or ID")
+	fmt.Println("  number                The pull request number")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --title <title>       New title")
+	fmt.Println("  --description <text>  New description (replaces the current one)")
+	fmt.Println("  --body-file <path>    Read the new description from a file (- for stdin)")
+	fmt.Println("  --assignee <user>     Assign a user (me, ID, name, or mail)")
+	fmt.Println("  --issue <issueKey>    Link an issue (e.g., PROJECT-123)")
+	fmt.Println("  --comment <text>      Comment to post with the change")
+	fmt.Println("  --raw                 Output raw JSON response")
+	fmt.Println("  -y, --yes             Skip confirmation prompt")
+	fmt.Println("  -h, --help            Show this help message")
+}
+
+func handlePRAttachments() {
+	// Parse arguments: bgl pr attachments [--raw] [--download] [--dir <dir>] [projectKey] <repo> <number>
+	args := os.Args[3:]
+
+	opts := pr.AttachmentsOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "--download":
+			opts.Download = true
+		case arg == "-d" || arg == "--dir":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a path\n", arg)
+				printPRAttachmentsUsage()
+				os.Exit(1)
+			}
+			i++
+			opts.Dir = args[i]
+		case strings.HasPrefix(arg, "--dir="):
+			opts.Dir = strings.TrimPrefix(arg, "--dir=")
+		case arg == "-h" || arg == "--help":
+			printPRAttachmentsUsage()
+			return
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 2)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key, repository, and pull request number are required")
+		printPRAttachmentsUsage()
+		os.Exit(1)
+	}
+
+	if err := pr.Attachments(projectKey, rest[0], rest[1], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printPRAttachmentsUsage() {
+	fmt.Println("Usage: bgl pr attachments [options] [projectKey] <repo> <number>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey          The project key or ID (default: the default project)")
+	fmt.Println("  repo                The repository name or ID")
+	fmt.Println("  number              The pull request number")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --download          Download all attachments instead of listing them")
+	fmt.Println("  -d, --dir <dir>     Directory to save to (default: current directory)")
+	fmt.Println("  --raw               Output raw JSON response")
+	fmt.Println("  -h, --help          Show this help message")
+}
+
+func handlePRCount() {
+	// Parse arguments: bgl pr count [--raw] [--status <status>] [--assignee <user>] [projectKey] <repo>
+	args := os.Args[3:]
+
+	opts := pr.CountOptions{}
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--raw":
+			opts.Raw = true
+		case arg == "-h" || arg == "--help":
+			printPRCountUsage()
+			return
+		case arg == "--status" || arg == "--assignee":
+			if i+1 >= len(args) {
+				fmt.Fprintf(os.Stderr, "Error: %s requires a value\n", arg)
+				printPRCountUsage()
+				os.Exit(1)
+			}
+			i++
+			if arg == "--status" {
+				opts.Status = args[i]
+			} else {
+				opts.Assignee = args[i]
+			}
+		case strings.HasPrefix(arg, "--status="):
+			opts.Status = strings.TrimPrefix(arg, "--status=")
+		case strings.HasPrefix(arg, "--assignee="):
+			opts.Assignee = strings.TrimPrefix(arg, "--assignee=")
+		case strings.HasPrefix(arg, "--"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printPRCountUsage()
+			os.Exit(1)
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 1)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key and repository are required")
+		printPRCountUsage()
+		os.Exit(1)
+	}
+
+	if err := pr.Count(projectKey, rest[0], opts); err != nil {
+		fail(err)
+	}
+}
+
+func printPRCountUsage() {
+	fmt.Println("Usage: bgl pr count [options] [projectKey] <repo>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey          The project key or ID (default: the default project)")
+	fmt.Println("  repo                The repository name or ID")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --status <status>   Only count open, closed, or merged pull requests")
+	fmt.Println("  --assignee <user>   Only count pull requests assigned to a user (me, ID, name, or mail)")
+	fmt.Println("  --raw               Output raw JSON response")
+	fmt.Println("  -h, --help          Show this help message")
+}
+
+func handlePRCheckout() {
+	// Parse arguments: bgl pr checkout <number>
+	args := os.Args[3:]
+
+	var number string
+
+	for _, arg := range args {
+		switch {
+		case arg == "-h" || arg == "--help":
+			printPRCheckoutUsage()
+			return
+		case strings.HasPrefix(arg, "-"):
+			fmt.Fprintf(os.Stderr, "Error: unknown option: %s\n", arg)
+			printPRCheckoutUsage()
+			os.Exit(1)
+		case number == "":
+			number = arg
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+			printPRCheckoutUsage()
+			os.Exit(1)
+		}
+	}
+
+	if number == "" {
+		fmt.Fprintln(os.Stderr, "Error: pull request number is required")
+		printPRCheckoutUsage()
+		os.Exit(1)
+	}
+
+	if err := pr.Checkout(number); err != nil {
+		fail(err)
+	}
+}
+
+func printPRCheckoutUsage() {
+	fmt.Println("Usage: bgl pr checkout <number>")
+	fmt.Println()
+	fmt.Println("Run inside a clone of a Backlog Git repository. The project and")
+	fmt.Println("repository are taken from the Backlog remote (origin preferred).")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  number      The pull request number")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handlePRStatus() {
+	// Parse arguments: bgl pr status [--raw]
+	args := os.Args[3:]
+
+	opts := pr.StatusOptions{}
+
+	for _, arg := range args {
+		switch arg {
+		case "--raw":
+			opts.Raw = true
+		case "-h", "--help":
+			printPRStatusUsage()
+			return
+		default:
+			fmt.Fprintf(os.Stderr, "Error: unexpected argument: %s\n", arg)
+			printPRStatusUsage()
+			os.Exit(1)
+		}
+	}
+
+	if err := pr.Status(opts); err != nil {
+		fail(err)
+	}
+}
+
+func printPRStatusUsage() {
+	fmt.Println("Usage: bgl pr status [options]")
+	fmt.Println()
+	fmt.Println("Run inside a clone of a Backlog Git repository. Shows the open pull")
+	fmt.Println("request from the current branch and its linked issue's status.")
+	fmt.Println()
+	fmt.Println("Options:")
+	fmt.Println("  --raw       Output raw JSON response")
+	fmt.Println("  -h, --help  Show this help message")
+}
+
+func handlePRBrowse() {
+	// Parse arguments: bgl pr browse [projectKey] <repo> <number>
+	args := os.Args[3:]
+
+	var positional []string
+
+	for _, arg := range args {
+		switch arg {
+		case "-h", "--help":
+			printPRBrowseUsage()
+			return
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectKey, rest, ok := projectArgs(positional, 2)
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Error: project key, repository, and pull request number are required")
+		printPRBrowseUsage()
+		os.Exit(1)
+	}
+
+	if err := pr.Browse(projectKey, rest[0], rest[1]); err != nil {
+		fail(err)
+	}
+}
+
+func printPRBrowseUsage() {
+	fmt.Println("Usage: bgl pr browse [projectKey] <repo> <number>")
+	fmt.Println()
+	fmt.Println("Arguments:")
+	fmt.Println("  projectKey  The project key or ID (default: the default project)")
+	fmt.Println("  repo        The repository name or ID")
+	fmt.Println("  number      The pull request number")
+	fmt.Println()
+	fmt.Println("Options:")
 	fmt.Println("  -h, --help  Show this help message")
 }
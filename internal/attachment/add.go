@@ -0,0 +1,77 @@
+package attachment
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// AddOptions contains options for the add command.
+type AddOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// Add uploads local files and attaches them to an issue.
+func Add(issueKeyOrID string, files []string, opts AddOptions) error {
+	for _, file := range files {
+		if _, err := os.Stat(file); err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+	}
+	if err := secrets.CheckFiles(files...); err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		details := []string{"Space: " + client.GetSpace(), "Issue: " + issueKeyOrID}
+		for _, file := range files {
+			details = append(details, "File: "+file)
+		}
+		ok, err := prompt.Confirm("Attach Files?", "Attach", details)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	uploaded, err := client.UploadFiles(files)
+	if err != nil {
+		return err
+	}
+
+	data := url.Values{}
+	data["attachmentId[]"] = backlog.AttachmentIDs(uploaded)
+	result, err := client.UpdateIssue(issueKeyOrID, data)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	issue, err := backlog.ParseIssue(result)
+	if err != nil {
+		return err
+	}
+	for _, attachment := range uploaded {
+		fmt.Printf("Attached to %s: %s\n", issue.IssueKey, attachment.Name)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/locale"
 )
 
 // DownloadOptions contains options for the download command.
@@ -36,6 +37,6 @@ func Download(issueKeyOrID string, attachmentID string, opts DownloadOptions) er
 		return fmt.Errorf("failed to write file: %w", err)
 	}
 
-	fmt.Printf("Downloaded: %s (%d bytes)\n", path, len(data))
+	fmt.Printf("Downloaded: %s (%s bytes)\n", path, locale.Number(int64(len(data))))
 	return nil
 }
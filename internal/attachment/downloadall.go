@@ -0,0 +1,263 @@
+package attachment
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+)
+
+// manifestFileName is the name of the manifest written next to the files.
+const manifestFileName = "manifest.json"
+
+// downloadWorkers is the number of attachments downloaded at once.
+const downloadWorkers = 4
+
+// DownloadAllOptions contains options for the download-all command.
+type DownloadAllOptions struct {
+	Dir string
+}
+
+// Manifest describes the files saved by DownloadAll.
+type Manifest struct {
+	IssueKey     string          `json:"issueKey"`
+	Space        string          `json:"space"`
+	DownloadedAt time.Time       `json:"downloadedAt"`
+	Attachments  []ManifestEntry `json:"attachments"`
+}
+
+// ManifestEntry describes one attachment in the manifest. CommentID is set
+// when the attachment was added with a comment.
+type ManifestEntry struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	File      string `json:"file,omitempty"`
+	Size      int64  `json:"size"`
+	CreatedBy string `json:"createdBy,omitempty"`
+	Created   string `json:"created,omitempty"`
+	CommentID int    `json:"commentId,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// DownloadAll downloads every attachment of an issue, including those added
+// with comments, into a directory and writes a manifest.
+func DownloadAll(issueKeyOrID string, opts DownloadAllOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetIssue(issueKeyOrID)
+	if err != nil {
+		return err
+	}
+	issue, err := backlog.ParseIssue(data)
+	if err != nil {
+		return err
+	}
+
+	data, err = client.GetIssueAttachments(issue.IssueKey)
+	if err != nil {
+		return err
+	}
+	attachments, err := backlog.ParseAttachments(data)
+	if err != nil {
+		return err
+	}
+
+	if len(attachments) == 0 {
+		fmt.Println("No attachments found.")
+		return nil
+	}
+
+	commentIDs, err := attachmentComments(client, issue.IssueKey)
+	if err != nil {
+		return err
+	}
+
+	dir := opts.Dir
+	if dir == "" {
+		dir = issue.IssueKey + "-files"
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	// Pick file names up front so concurrent downloads never collide
+	entries := make([]ManifestEntry, len(attachments))
+	used := map[string]bool{manifestFileName: true}
+	for i, attachment := range attachments {
+		entries[i] = ManifestEntry{
+			ID:        attachment.ID,
+			Name:      attachment.Name,
+			File:      uniqueName(attachment.Name, attachment.ID, used),
+			Size:      attachment.Size,
+			Created:   attachment.Created,
+			CommentID: commentIDs[attachment.ID],
+		}
+		if attachment.CreatedUser != nil {
+			entries[i].CreatedBy = attachment.CreatedUser.Name
+		}
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for range min(downloadWorkers, len(entries)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each worker has its own client, since a token refresh
+			// updates the client's config
+			worker, clientErr := backlog.NewClient()
+			for i := range jobs {
+				entry := &entries[i]
+				err := clientErr
+				if err == nil {
+					err = downloadTo(worker, issue.IssueKey, entry.ID, filepath.Join(dir, entry.File))
+				}
+
+				mu.Lock()
+				if err != nil {
+					entry.Error = err.Error()
+					fmt.Fprintf(os.Stderr, "Failed: %s: %v\n", entry.Name, err)
+				} else {
+					fmt.Printf("Downloaded: %s\n", filepath.Join(dir, entry.File))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	// On Ctrl-C, stop handing out downloads, let those in flight finish,
+	// and write the manifest for what was fetched.
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+
+	started := 0
+feed:
+	for started < len(entries) {
+		select {
+		case <-interrupted:
+			break feed
+		case jobs <- started:
+			started++
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	entries = entries[:started]
+	failed := 0
+	for i := range entries {
+		if entries[i].Error != "" {
+			entries[i].File = ""
+			failed++
+		}
+	}
+
+	manifest := Manifest{
+		IssueKey:     issue.IssueKey,
+		Space:        client.GetSpace(),
+		DownloadedAt: time.Now(),
+		Attachments:  entries,
+	}
+	out, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	manifestPath := filepath.Join(dir, manifestFileName)
+	if err := os.WriteFile(manifestPath, out, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	fmt.Printf("Manifest: %s\n", manifestPath)
+
+	if started < len(attachments) {
+		fmt.Printf("Interrupted after %d of %d attachment(s); the manifest lists only those. Run the command again to download all attachments.\n",
+			started, len(attachments))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d attachments failed to download", failed, len(entries))
+	}
+	return nil
+}
+
+// downloadTo downloads an attachment and writes it to path.
+func downloadTo(client *backlog.Client, issueKey string, attachmentID int, path string) error {
+	data, _, err := client.DownloadIssueAttachment(issueKey, strconv.Itoa(attachmentID))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+// attachmentComments maps attachment IDs to the ID of the comment they were
+// added with, following all comment pages.
+func attachmentComments(client *backlog.Client, issueKey string) (map[int]int, error) {
+	commentIDs := map[int]int{}
+
+	query := url.Values{}
+	query.Set("count", "100")
+	query.Set("order", "asc")
+
+	seen := map[int]bool{}
+	for {
+		data, err := client.GetComments(issueKey, query)
+		if err != nil {
+			return nil, err
+		}
+		comments, err := backlog.ParseComments(data)
+		if err != nil {
+			return nil, err
+		}
+
+		fetched := 0
+		for _, comment := range comments {
+			if seen[comment.ID] {
+				continue
+			}
+			seen[comment.ID] = true
+			fetched++
+
+			for _, change := range comment.ChangeLog {
+				if change.AttachmentInfo != nil {
+					commentIDs[change.AttachmentInfo.ID] = comment.ID
+				}
+			}
+		}
+		if fetched == 0 {
+			return commentIDs, nil
+		}
+		query.Set("minId", strconv.Itoa(comments[len(comments)-1].ID))
+	}
+}
+
+// uniqueName returns a safe file name for an attachment that is not yet in
+// used, adding " (2)", " (3)", ... before the extension on collisions.
+func uniqueName(name string, id int, used map[string]bool) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" || name == ".." || name == "" {
+		name = "attachment-" + strconv.Itoa(id)
+	}
+
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	candidate := name
+	for n := 2; used[strings.ToLower(candidate)]; n++ {
+		candidate = fmt.Sprintf("%s (%d)%s", base, n, ext)
+	}
+	used[strings.ToLower(candidate)] = true
+	return candidate
+}
@@ -1,11 +1,8 @@
 package attachment
 
 import (
-	"encoding/json"
-	"fmt"
-
-	"github.com/charmbracelet/glamour"
 	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
 )
 
 // ListOptions contains options for the list command.
@@ -26,19 +23,7 @@ func List(issueKeyOrID string, opts ListOptions) error {
 	}
 
 	if opts.Raw {
-		// Pretty print JSON
-		var prettyJSON []any
-		if err := json.Unmarshal(data, &prettyJSON); err != nil {
-			// If pretty print fails, output raw
-			fmt.Println(string(data))
-			return nil
-		}
-		formatted, err := json.MarshalIndent(prettyJSON, "", "  ")
-		if err != nil {
-			fmt.Println(string(data))
-			return nil
-		}
-		fmt.Println(string(formatted))
+		render.JSON(data)
 		return nil
 	}
 
@@ -49,22 +34,6 @@ func List(issueKeyOrID string, opts ListOptions) error {
 
 	markdown := backlog.FormatAttachmentsMarkdown(attachments)
 
-	renderer, err := glamour.NewTermRenderer(
-		glamour.WithAutoStyle(),
-		glamour.WithWordWrap(100),
-	)
-	if err != nil {
-		// Fallback to plain output if renderer fails
-		fmt.Print(markdown)
-		return nil
-	}
-
-	rendered, err := renderer.Render(markdown)
-	if err != nil {
-		fmt.Print(markdown)
-		return nil
-	}
-
-	fmt.Print(rendered)
+	render.Markdown(markdown)
 	return nil
 }
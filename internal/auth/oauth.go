@@ -15,6 +15,7 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/dannygim/bgl/internal/config"
 )
@@ -197,8 +198,11 @@ func Login() error {
 		return fmt.Errorf("cancelled by user")
 	}
 
-	space := m.textInput.Value()
+	return LoginSpace(m.textInput.Value())
+}
 
+// LoginSpace performs the OAuth 2.0 login flow for the given space.
+func LoginSpace(space string) error {
 	if config.ClientID == "" || config.ClientSecret == "" {
 		return fmt.Errorf("OAuth client credentials are not configured. Please build with the required configuration flags")
 	}
@@ -268,7 +272,7 @@ func Login() error {
 	fmt.Println("\nOpening browser for authentication...")
 	fmt.Printf("If browser doesn't open automatically, please visit:\n%s\n\n", authURL)
 
-	if err := openBrowser(authURL); err != nil {
+	if err := OpenBrowser(authURL); err != nil {
 		fmt.Printf("Failed to open browser: %v\n", err)
 	}
 
@@ -281,7 +285,7 @@ func Login() error {
 	}()
 
 	sp := newSpinnerModel("Waiting for authentication...", resultChan)
-	p = tea.NewProgram(sp)
+	p := tea.NewProgram(sp)
 	finalSpinnerModel, err := p.Run()
 	if err != nil {
 		return fmt.Errorf("spinner error: %w", err)
@@ -305,6 +309,7 @@ func Login() error {
 	cfg.AccessToken = token.AccessToken
 	cfg.RefreshToken = token.RefreshToken
 	cfg.ExpiresAt = time.Now().UnixMilli() + int64(token.ExpiresIn)*1000
+	cfg.APIKey = ""
 
 	if err := cfg.Save(); err != nil {
 		return fmt.Errorf("failed to save config: %w", err)
@@ -314,6 +319,89 @@ func Login() error {
 	return nil
 }
 
+// LoginWithAPIKey prompts for a space and a personal API key, verifies the
+// key, and stores it in place of any OAuth tokens.
+func LoginWithAPIKey() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	space := cfg.Space
+	if err := huh.NewInput().
+		Title("Space").
+		Description("Enter your Backlog space (e.g. myspace.backlog.com)").
+		Placeholder("myspace.backlog.com").
+		Validate(ValidateSpace).
+		Value(&space).
+		Run(); err != nil {
+		return fmt.Errorf("failed to get space input: %w", err)
+	}
+
+	return LoginSpaceWithAPIKey(space)
+}
+
+// LoginSpaceWithAPIKey prompts for a personal API key for the given space,
+// verifies it, and stores it in place of any OAuth tokens.
+func LoginSpaceWithAPIKey(space string) error {
+	var apiKey string
+	if err := huh.NewInput().
+		Title("API key").
+		Description("Create one under Personal Settings > API in Backlog").
+		EchoMode(huh.EchoModePassword).
+		Validate(func(s string) error {
+			if strings.TrimSpace(s) == "" {
+				return fmt.Errorf("API key is required")
+			}
+			return nil
+		}).
+		Value(&apiKey).
+		Run(); err != nil {
+		return fmt.Errorf("failed to get API key input: %w", err)
+	}
+	apiKey = strings.TrimSpace(apiKey)
+
+	if err := verifyAPIKey(getBacklogBaseURL(space), apiKey); err != nil {
+		return err
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	cfg.Space = space
+	cfg.APIKey = apiKey
+	cfg.AccessToken = ""
+	cfg.RefreshToken = ""
+	cfg.ExpiresAt = 0
+
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Println("Login successful! API key saved to config.")
+	return nil
+}
+
+// verifyAPIKey checks the API key by fetching the authenticated user.
+func verifyAPIKey(baseURL, apiKey string) error {
+	resp, err := http.Get(baseURL + "/api/v2/users/myself?apiKey=" + url.QueryEscape(apiKey))
+	if err != nil {
+		// The URL, and so the key, is part of the error message
+		return fmt.Errorf("failed to connect to %s", baseURL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return fmt.Errorf("API key was rejected by %s", baseURL)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API key check failed with status: %d", resp.StatusCode)
+	}
+	return nil
+}
+
 // exchangeCode exchanges the authorization code for tokens.
 func exchangeCode(baseURL, code, redirectURI string) (*TokenResponse, error) {
 	tokenURL := baseURL + "/api/v2/oauth2/token"
@@ -343,20 +431,21 @@ func exchangeCode(baseURL, code, redirectURI string) (*TokenResponse, error) {
 	return &token, nil
 }
 
-// Logout removes the stored access token and refresh token.
+// Logout removes the stored access token, refresh token, and API key.
 func Logout() error {
 	cfg, err := config.Load()
 	if err != nil {
 		return fmt.Errorf("failed to load config: %w", err)
 	}
 
-	if cfg.AccessToken == "" && cfg.RefreshToken == "" {
+	if cfg.AccessToken == "" && cfg.RefreshToken == "" && cfg.APIKey == "" {
 		return fmt.Errorf("not logged in")
 	}
 
 	cfg.AccessToken = ""
 	cfg.RefreshToken = ""
 	cfg.ExpiresAt = 0
+	cfg.APIKey = ""
 
 	if err := cfg.Save(); err != nil {
 		return fmt.Errorf("failed to save config: %w", err)
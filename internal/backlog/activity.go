@@ -0,0 +1,213 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/locale"
+)
+
+// ActivityType describes a Backlog activity type. Name is the short name
+// accepted on the command line.
+type ActivityType struct {
+	ID    int
+	Name  string
+	Label string
+}
+
+// ActivityTypes lists the activity types, used by activities and webhooks.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-recent-updates/
+var ActivityTypes = []ActivityType{
+	{1, "issue-created", "Issue created"},
+	{2, "issue-updated", "Issue updated"},
+	{3, "issue-commented", "Issue commented"},
+	{4, "issue-deleted", "Issue deleted"},
+	{5, "wiki-created", "Wiki created"},
+	{6, "wiki-updated", "Wiki updated"},
+	{7, "wiki-deleted", "Wiki deleted"},
+	{8, "file-added", "File added"},
+	{9, "file-updated", "File updated"},
+	{10, "file-deleted", "File deleted"},
+	{11, "svn-committed", "Subversion committed"},
+	{12, "git-pushed", "Git pushed"},
+	{13, "git-repository-created", "Git repository created"},
+	{14, "issue-multi-updated", "Issues updated in bulk"},
+	{15, "project-user-added", "Project user added"},
+	{16, "project-user-removed", "Project user removed"},
+	{17, "comment-notification-added", "Comment notification added"},
+	{18, "pull-request-added", "Pull request added"},
+	{19, "pull-request-updated", "Pull request updated"},
+	{20, "pull-request-commented", "Pull request commented"},
+	{21, "pull-request-deleted", "Pull request deleted"},
+	{22, "milestone-created", "Milestone created"},
+	{23, "milestone-updated", "Milestone updated"},
+	{24, "milestone-deleted", "Milestone deleted"},
+	{25, "project-team-added", "Project team added"},
+	{26, "project-team-removed", "Project team removed"},
+}
+
+// ActivityTypeGroups maps group names to the activity types they cover, so
+// related types can be selected together.
+var ActivityTypeGroups = map[string][]int{
+	"issue":        {1, 2, 4, 14},
+	"comment":      {3, 17},
+	"wiki":         {5, 6, 7},
+	"file":         {8, 9, 10},
+	"svn":          {11},
+	"git":          {12, 13},
+	"pull-request": {18, 19, 20, 21},
+	"milestone":    {22, 23, 24},
+	"project":      {15, 16, 25, 26},
+}
+
+// ActivityTypeLabel returns the label of an activity type ID.
+func ActivityTypeLabel(id int) string {
+	for _, activityType := range ActivityTypes {
+		if activityType.ID == id {
+			return activityType.Label
+		}
+	}
+	return fmt.Sprintf("Activity type %d", id)
+}
+
+// ParseActivityTypes resolves a comma-separated list of activity type IDs,
+// names, or group names to IDs.
+func ParseActivityTypes(list string) ([]int, error) {
+	var ids []int
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if group, ok := ActivityTypeGroups[strings.ToLower(part)]; ok {
+			ids = append(ids, group...)
+			continue
+		}
+		found := false
+		for _, activityType := range ActivityTypes {
+			if strconv.Itoa(activityType.ID) == part || strings.EqualFold(activityType.Name, part) {
+				ids = append(ids, activityType.ID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown activity type: %s", part)
+		}
+	}
+	return ids, nil
+}
+
+// Activity represents an entry of a recent activity feed. Content depends
+// on the activity type.
+type Activity struct {
+	ID          int             `json:"id"`
+	Project     ActivityProject `json:"project"`
+	Type        int             `json:"type"`
+	Content     ActivityContent `json:"content"`
+	CreatedUser *CommentUser    `json:"createdUser"`
+	Created     string          `json:"created"`
+}
+
+// ActivityProject identifies the project of an activity.
+type ActivityProject struct {
+	ID         int    `json:"id"`
+	ProjectKey string `json:"projectKey"`
+	Name       string `json:"name"`
+}
+
+// ActivityContent holds the fields of an activity's content used for
+// display. Issues set KeyID and Summary; wikis and milestones set Name;
+// files set Dir and Name; Git and pull request activities set Repository.
+type ActivityContent struct {
+	ID         int                 `json:"id"`
+	KeyID      int                 `json:"key_id"`
+	Summary    string              `json:"summary"`
+	Name       string              `json:"name"`
+	Dir        string              `json:"dir"`
+	Number     int                 `json:"number"`
+	Ref        string              `json:"ref"`
+	Repository *ActivityRepository `json:"repository"`
+	Comment    *ActivityComment    `json:"comment"`
+}
+
+// ActivityRepository identifies the Git repository of an activity.
+type ActivityRepository struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ActivityComment is the comment added by an activity.
+type ActivityComment struct {
+	ID      int    `json:"id"`
+	Content string `json:"content"`
+}
+
+// ParseActivities parses the JSON response into a slice of Activity structs.
+func ParseActivities(data []byte) ([]Activity, error) {
+	var activities []Activity
+	if err := json.Unmarshal(data, &activities); err != nil {
+		return nil, fmt.Errorf("failed to parse activities: %w", err)
+	}
+	return activities, nil
+}
+
+// ActivityTarget describes what an activity was done to, such as an issue
+// key and summary or a wiki page name.
+func ActivityTarget(activity *Activity) string {
+	content := activity.Content
+	switch {
+	case content.KeyID > 0:
+		return fmt.Sprintf("%s-%d %s", activity.Project.ProjectKey, content.KeyID, content.Summary)
+	case content.Repository != nil && content.Number > 0:
+		return fmt.Sprintf("%s#%d %s", content.Repository.Name, content.Number, content.Summary)
+	case content.Repository != nil && content.Ref != "":
+		return fmt.Sprintf("%s (%s)", content.Repository.Name, strings.TrimPrefix(content.Ref, "refs/heads/"))
+	case content.Repository != nil:
+		return content.Repository.Name
+	case content.Dir != "":
+		return content.Dir + content.Name
+	}
+	return content.Name
+}
+
+// FormatActivitiesMarkdown formats activities as a Markdown timeline of
+// who did what, when, and to what.
+func FormatActivitiesMarkdown(activities []Activity) string {
+	return formatActivities(activities, false)
+}
+
+// FormatCrossProjectActivitiesMarkdown formats activities from several
+// projects like FormatActivitiesMarkdown, tagging each with its project key.
+func FormatCrossProjectActivitiesMarkdown(activities []Activity) string {
+	return formatActivities(activities, true)
+}
+
+func formatActivities(activities []Activity, showProject bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Activity\n")
+	if len(activities) == 0 {
+		sb.WriteString("\nNo activity.\n")
+		return sb.String()
+	}
+	for _, activity := range activities {
+		user := "(unknown)"
+		if activity.CreatedUser != nil {
+			user = activity.CreatedUser.Name
+		}
+		fmt.Fprintf(&sb, "- %s ", locale.DateTimeString(activity.Created))
+		if showProject && activity.Project.ProjectKey != "" {
+			fmt.Fprintf(&sb, "[%s] ", activity.Project.ProjectKey)
+		}
+		fmt.Fprintf(&sb, "**%s** — %s", user, ActivityTypeLabel(activity.Type))
+		if target := ActivityTarget(&activity); target != "" {
+			fmt.Fprintf(&sb, ": %s", target)
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,166 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dannygim/bgl/internal/config"
+)
+
+// capabilitiesFileName is the name of the capability cache in the state dir.
+const capabilitiesFileName = "capabilities.json"
+
+// capabilitiesTTL is how long cached capabilities are trusted.
+const capabilitiesTTL = 24 * time.Hour
+
+// Capability names.
+const (
+	CapabilityGit            = "git"
+	CapabilitySubversion     = "subversion"
+	CapabilityWikiAttachment = "wiki attachments"
+	CapabilityFileSharing    = "file sharing"
+	CapabilityGantt          = "gantt chart"
+	CapabilityBurndown       = "burndown chart"
+	CapabilityCustomFields   = "custom fields"
+	CapabilityParentChild    = "parent/child issues"
+)
+
+// Capabilities records which plan features a space has.
+type Capabilities struct {
+	Space     string          `json:"space"`
+	CheckedAt time.Time       `json:"checked_at"`
+	Features  map[string]bool `json:"features"`
+}
+
+// GetLicence retrieves the licence of the space.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-licence/
+func (c *Client) GetLicence() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/space/licence")
+}
+
+// Licence represents the licence of a Backlog space.
+type Licence struct {
+	Active           bool   `json:"active"`
+	LicenceTypeID    int    `json:"licenceTypeId"`
+	StartedOn        string `json:"startedOn"`
+	LimitDate        string `json:"limitDate"`
+	UserLimit        int64  `json:"userLimit"`
+	ProjectLimit     int64  `json:"projectLimit"`
+	IssueLimit       int64  `json:"issueLimit"`
+	StorageLimit     int64  `json:"storageLimit"`
+	AttachmentLimit  int64  `json:"attachmentLimit"`
+	Git              bool   `json:"git"`
+	Subversion       bool   `json:"subversion"`
+	WikiAttachment   bool   `json:"wikiAttachment"`
+	FileSharing      bool   `json:"fileSharing"`
+	Gantt            bool   `json:"gantt"`
+	Burndown         bool   `json:"burndown"`
+	Attribute        bool   `json:"attribute"`
+	ParentChildIssue bool   `json:"parentChildIssue"`
+}
+
+// ParseLicence parses the JSON response into a Licence struct.
+func ParseLicence(data []byte) (*Licence, error) {
+	var licence Licence
+	if err := json.Unmarshal(data, &licence); err != nil {
+		return nil, fmt.Errorf("failed to parse licence: %w", err)
+	}
+	return &licence, nil
+}
+
+func getCapabilitiesPath() (string, error) {
+	stateDir, err := config.GetStateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(stateDir, capabilitiesFileName), nil
+}
+
+// loadCapabilities reads the cache, keyed by space.
+func loadCapabilities() map[string]*Capabilities {
+	cache := map[string]*Capabilities{}
+	path, err := getCapabilitiesPath()
+	if err != nil {
+		return cache
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	_ = json.Unmarshal(data, &cache)
+	return cache
+}
+
+func saveCapabilities(cache map[string]*Capabilities) error {
+	stateDir, err := config.GetStateDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return err
+	}
+	path, err := getCapabilitiesPath()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Capabilities returns the plan features of the space, probing the licence
+// API if the cache is missing, stale, or refresh is set.
+func (c *Client) Capabilities(refresh bool) (*Capabilities, error) {
+	cache := loadCapabilities()
+	if cached, ok := cache[c.cfg.Space]; ok && !refresh && time.Since(cached.CheckedAt) < capabilitiesTTL {
+		return cached, nil
+	}
+
+	data, err := c.GetLicence()
+	if err != nil {
+		return nil, err
+	}
+	licence, err := ParseLicence(data)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := &Capabilities{
+		Space:     c.cfg.Space,
+		CheckedAt: time.Now(),
+		Features: map[string]bool{
+			CapabilityGit:            licence.Git,
+			CapabilitySubversion:     licence.Subversion,
+			CapabilityWikiAttachment: licence.WikiAttachment,
+			CapabilityFileSharing:    licence.FileSharing,
+			CapabilityGantt:          licence.Gantt,
+			CapabilityBurndown:       licence.Burndown,
+			CapabilityCustomFields:   licence.Attribute,
+			CapabilityParentChild:    licence.ParentChildIssue,
+		},
+	}
+
+	cache[c.cfg.Space] = capabilities
+	// A failed cache write only costs a probe next time
+	_ = saveCapabilities(cache)
+
+	return capabilities, nil
+}
+
+// Require returns an error if the space's plan is known not to include the
+// feature. If the capabilities cannot be probed, it does not block.
+func (c *Client) Require(feature string) error {
+	capabilities, err := c.Capabilities(false)
+	if err != nil {
+		return nil
+	}
+	if enabled, ok := capabilities.Features[feature]; ok && !enabled {
+		return fmt.Errorf("your plan doesn't include %s (space: %s)", feature, c.cfg.Space)
+	}
+	return nil
+}
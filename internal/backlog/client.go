@@ -1,17 +1,24 @@
 package backlog
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dannygim/bgl/internal/auth"
 	"github.com/dannygim/bgl/internal/config"
+	"github.com/dannygim/bgl/internal/locale"
 )
 
 // Client is a Backlog API client with automatic token management.
@@ -28,12 +35,12 @@ func NewClient() (*Client, error) {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
-	if cfg.AccessToken == "" {
-		return nil, fmt.Errorf("not logged in. Please run 'bgl auth login' first")
+	if cfg.AccessToken == "" && cfg.APIKey == "" {
+		return nil, fmt.Errorf("not logged in. Please run 'bgl init' or 'bgl auth login' first")
 	}
 
 	// Check if token is expired and refresh if needed
-	if cfg.ExpiresAt > 0 && time.Now().UnixMilli() >= cfg.ExpiresAt {
+	if cfg.AccessToken != "" && cfg.ExpiresAt > 0 && time.Now().UnixMilli() >= cfg.ExpiresAt {
 		if err := auth.RefreshToken(); err != nil {
 			return nil, fmt.Errorf("failed to refresh token: %w", err)
 		}
@@ -46,10 +53,42 @@ func NewClient() (*Client, error) {
 
 	return &Client{
 		cfg:        cfg,
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+		httpClient: &http.Client{Timeout: 30 * time.Second, Transport: newTransport(cfg.RequestLog)},
 	}, nil
 }
 
+// authorize adds credentials to req: the OAuth access token when logged in
+// with OAuth, otherwise the API key as the apiKey query parameter.
+func (c *Client) authorize(req *http.Request) {
+	if c.cfg.AccessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.cfg.AccessToken)
+		return
+	}
+	query := req.URL.Query()
+	query.Set("apiKey", c.cfg.APIKey)
+	req.URL.RawQuery = query.Encode()
+}
+
+// send performs req. The query string is dropped from the URL of a
+// transport error, since it may carry the API key; the *url.Error itself is
+// kept so callers can still tell network failures apart.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+				u.RawQuery = ""
+				urlErr.URL = u.String()
+			} else {
+				urlErr.URL = req.URL.Scheme + "://" + req.URL.Host + req.URL.Path
+			}
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
 // doRequest performs an HTTP request with authentication and error handling.
 func (c *Client) doRequest(method, path string) ([]byte, error) {
 	url := fmt.Sprintf("https://%s%s", c.cfg.Space, path)
@@ -59,9 +98,9 @@ func (c *Client) doRequest(method, path string) ([]byte, error) {
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.cfg.AccessToken)
+	c.authorize(req)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -107,10 +146,25 @@ func (c *Client) GetIssue(issueKeyOrID string) ([]byte, error) {
 	return c.doRequest("GET", "/api/v2/issues/"+issueKeyOrID)
 }
 
-// GetComments retrieves comments for an issue.
+// GetIssues retrieves the issue list matching the query.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-issue-list/
+func (c *Client) GetIssues(query url.Values) ([]byte, error) {
+	path := "/api/v2/issues"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// GetComments retrieves comments for an issue. The query may set minId,
+// maxId, count, and order.
 // ref: https://developer.nulab.com/docs/backlog/api/2/get-comment-list/
-func (c *Client) GetComments(issueKeyOrID string) ([]byte, error) {
-	return c.doRequest("GET", "/api/v2/issues/"+issueKeyOrID+"/comments")
+func (c *Client) GetComments(issueKeyOrID string, query url.Values) ([]byte, error) {
+	path := "/api/v2/issues/" + issueKeyOrID + "/comments"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
 }
 
 // GetComment retrieves a specific comment by ID.
@@ -128,10 +182,10 @@ func (c *Client) doPostRequest(path string, data url.Values) ([]byte, error) {
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.cfg.AccessToken)
+	c.authorize(req)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -171,11 +225,14 @@ func (c *Client) doPostRequest(path string, data url.Values) ([]byte, error) {
 	return body, nil
 }
 
-// AddComment adds a comment to an issue.
+// AddComment adds a comment to an issue, notifying the given user IDs.
 // ref: https://developer.nulab.com/docs/backlog/api/2/add-comment/
-func (c *Client) AddComment(issueKeyOrID string, content string) ([]byte, error) {
+func (c *Client) AddComment(issueKeyOrID string, content string, notifiedUserIDs []string) ([]byte, error) {
 	data := url.Values{}
 	data.Set("content", content)
+	if len(notifiedUserIDs) > 0 {
+		data["notifiedUserId[]"] = notifiedUserIDs
+	}
 	return c.doPostRequest("/api/v2/issues/"+issueKeyOrID+"/comments", data)
 }
 
@@ -188,10 +245,10 @@ func (c *Client) doPatchRequest(path string, data url.Values) ([]byte, error) {
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.cfg.AccessToken)
+	c.authorize(req)
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, err
 	}
@@ -231,6 +288,150 @@ func (c *Client) doPatchRequest(path string, data url.Values) ([]byte, error) {
 	return body, nil
 }
 
+// doDeleteRequest performs an HTTP DELETE request with form data.
+func (c *Client) doDeleteRequest(path string, data url.Values) ([]byte, error) {
+	apiURL := fmt.Sprintf("https://%s%s", c.cfg.Space, path)
+
+	req, err := http.NewRequest("DELETE", apiURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	c.authorize(req)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle authentication errors
+	if resp.StatusCode == http.StatusUnauthorized {
+		wwwAuth := resp.Header.Get("WWW-Authenticate")
+		if strings.Contains(wwwAuth, "The access token expired") {
+			// Token expired - try to refresh
+			if err := auth.RefreshToken(); err != nil {
+				return nil, fmt.Errorf("access token expired and refresh failed: %w. Please run 'bgl auth login'", err)
+			}
+			// Reload config and retry
+			cfg, err := config.Load()
+			if err != nil {
+				return nil, fmt.Errorf("failed to reload config: %w", err)
+			}
+			c.cfg = cfg
+			return c.doDeleteRequest(path, data)
+		}
+		if strings.Contains(wwwAuth, "The access token is invalid") {
+			return nil, fmt.Errorf("access token is invalid. Please run 'bgl auth login'")
+		}
+		return nil, fmt.Errorf("authentication failed (status %d). Please run 'bgl auth login'", resp.StatusCode)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
+// doPutRequest performs an HTTP PUT request with form data.
+func (c *Client) doPutRequest(path string, data url.Values) ([]byte, error) {
+	apiURL := fmt.Sprintf("https://%s%s", c.cfg.Space, path)
+
+	req, err := http.NewRequest("PUT", apiURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	c.authorize(req)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle authentication errors
+	if resp.StatusCode == http.StatusUnauthorized {
+		wwwAuth := resp.Header.Get("WWW-Authenticate")
+		if strings.Contains(wwwAuth, "The access token expired") {
+			// Token expired - try to refresh
+			if err := auth.RefreshToken(); err != nil {
+				return nil, fmt.Errorf("access token expired and refresh failed: %w. Please run 'bgl auth login'", err)
+			}
+			// Reload config and retry
+			cfg, err := config.Load()
+			if err != nil {
+				return nil, fmt.Errorf("failed to reload config: %w", err)
+			}
+			c.cfg = cfg
+			return c.doPutRequest(path, data)
+		}
+		if strings.Contains(wwwAuth, "The access token is invalid") {
+			return nil, fmt.Errorf("access token is invalid. Please run 'bgl auth login'")
+		}
+		return nil, fmt.Errorf("authentication failed (status %d). Please run 'bgl auth login'", resp.StatusCode)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
+// UpdateComment updates the content of a comment.
+// ref: https://developer.nulab.com/docs/backlog/api/2/update-comment/
+func (c *Client) UpdateComment(issueKeyOrID string, commentID string, content string) ([]byte, error) {
+	data := url.Values{}
+	data.Set("content", content)
+	return c.doPatchRequest("/api/v2/issues/"+issueKeyOrID+"/comments/"+commentID, data)
+}
+
+// DeleteComment deletes a comment.
+// ref: https://developer.nulab.com/docs/backlog/api/2/delete-comment/
+func (c *Client) DeleteComment(issueKeyOrID string, commentID string) ([]byte, error) {
+	return c.doRequest("DELETE", "/api/v2/issues/"+issueKeyOrID+"/comments/"+commentID)
+}
+
+// GetCommentCount retrieves the number of comments on an issue.
+// ref: https://developer.nulab.com/docs/backlog/api/2/count-comment/
+func (c *Client) GetCommentCount(issueKeyOrID string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/issues/"+issueKeyOrID+"/comments/count")
+}
+
+// Count represents the response of a count API.
+type Count struct {
+	Count int `json:"count"`
+}
+
+// ParseCount parses the JSON response into a Count struct.
+func ParseCount(data []byte) (*Count, error) {
+	var count Count
+	if err := json.Unmarshal(data, &count); err != nil {
+		return nil, fmt.Errorf("failed to parse count: %w", err)
+	}
+	return &count, nil
+}
+
+// GetCommentNotifications retrieves the notifications sent for a comment.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-list-of-comment-notifications/
+func (c *Client) GetCommentNotifications(issueKeyOrID string, commentID string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/issues/"+issueKeyOrID+"/comments/"+commentID+"/notifications")
+}
+
 // UpdateIssue updates an issue.
 // ref: https://developer.nulab.com/docs/backlog/api/2/update-issue/
 func (c *Client) UpdateIssue(issueKeyOrID string, data url.Values) ([]byte, error) {
@@ -248,16 +449,101 @@ func (c *Client) GetSpace() string {
 	return c.cfg.Space
 }
 
+// CommentURL returns the permalink of a comment on an issue.
+func CommentURL(space string, issueKey string, commentID int) string {
+	return fmt.Sprintf("https://%s/view/%s#comment-%d", space, issueKey, commentID)
+}
+
 // Issue represents a Backlog issue.
 type Issue struct {
-	ProjectId   int       `json:"projectId"`
-	IssueKey    string    `json:"issueKey"`
-	Summary     string    `json:"summary"`
-	Description string    `json:"description"`
-	Assignee    *Assignee `json:"assignee"`
-	Status      *Status   `json:"status"`
+	ID          int         `json:"id"`
+	ProjectId   int         `json:"projectId"`
+	IssueKey    string      `json:"issueKey"`
+	Summary     string      `json:"summary"`
+	Description string      `json:"description"`
+	IssueType   *IssueType  `json:"issueType"`
+	Assignee    *Assignee   `json:"assignee"`
+	Status      *Status     `json:"status"`
+	Resolution  *Resolution `json:"resolution"`
+	Priority    *Priority   `json:"priority"`
+	DueDate     string      `json:"dueDate"`
+	Updated     string      `json:"updated"`
 }
 
+// ParseIssues parses the JSON response into a slice of Issue structs.
+func ParseIssues(data []byte) ([]Issue, error) {
+	var issues []Issue
+	if err := json.Unmarshal(data, &issues); err != nil {
+		return nil, fmt.Errorf("failed to parse issues: %w", err)
+	}
+	return issues, nil
+}
+
+// FormatIssuesMarkdown formats a list of issues as Markdown.
+func FormatIssuesMarkdown(issues []Issue) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Issue\n")
+	for _, issue := range issues {
+		fmt.Fprintf(&sb, "- %s %s", issue.IssueKey, issue.Summary)
+		if issue.Status != nil {
+			fmt.Fprintf(&sb, " (%s", issue.Status.Name)
+		} else {
+			sb.WriteString(" ((unknown)")
+		}
+		if issue.Assignee != nil {
+			fmt.Fprintf(&sb, ", %s", issue.Assignee.Name)
+		}
+		if issue.DueDate != "" {
+			fmt.Fprintf(&sb, ", due: %s", locale.DateString(issue.DueDate))
+		}
+		sb.WriteString(")\n")
+	}
+
+	return sb.String()
+}
+
+// IssueTSVColumns is version 1 of the issue list TSV column contract.
+// Columns are never reordered or removed; new columns are only added
+// behind a flag.
+var IssueTSVColumns = []string{"key", "id", "summary", "status", "assignee", "due", "updated"}
+
+// FormatIssuesTSV formats a list of issues as tab-separated values, one
+// issue per line in IssueTSVColumns order, without a header.
+func FormatIssuesTSV(issues []Issue) string {
+	var sb strings.Builder
+
+	for _, issue := range issues {
+		status := ""
+		if issue.Status != nil {
+			status = issue.Status.Name
+		}
+		assignee := ""
+		if issue.Assignee != nil {
+			assignee = issue.Assignee.Name
+		}
+		fields := []string{
+			issue.IssueKey,
+			strconv.Itoa(issue.ID),
+			issue.Summary,
+			status,
+			assignee,
+			formatDate(issue.DueDate),
+			issue.Updated,
+		}
+		for i, field := range fields {
+			fields[i] = tsvEscaper.Replace(field)
+		}
+		sb.WriteString(strings.Join(fields, "\t"))
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// tsvEscaper replaces characters that would break TSV rows with spaces.
+var tsvEscaper = strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ")
+
 // Assignee represents the assignee of an issue.
 type Assignee struct {
 	Name        string `json:"name"`
@@ -266,6 +552,7 @@ type Assignee struct {
 
 // Status represents the status of an issue.
 type Status struct {
+	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
 
@@ -289,6 +576,9 @@ func FormatIssueMarkdown(issue *Issue) string {
 	} else {
 		sb.WriteString("- Status: (unknown)\n")
 	}
+	if issue.Resolution != nil {
+		fmt.Fprintf(&sb, "- Resolution: %s\n", issue.Resolution.Name)
+	}
 	if issue.Assignee != nil {
 		fmt.Fprintf(&sb, "- Assignee: %s`<%s>`\n", issue.Assignee.Name, issue.Assignee.MailAddress)
 	} else {
@@ -309,16 +599,54 @@ func FormatIssueMarkdown(issue *Issue) string {
 	return sb.String()
 }
 
+// FormatJSONL formats a JSON response as JSON Lines: each element of an
+// array on its own line, or a single object on one line.
+func FormatJSONL(data []byte) (string, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		items = []json.RawMessage{data}
+	}
+
+	var sb strings.Builder
+	for _, item := range items {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, item); err != nil {
+			return "", fmt.Errorf("failed to format JSON lines: %w", err)
+		}
+		sb.Write(buf.Bytes())
+		sb.WriteString("\n")
+	}
+
+	return sb.String(), nil
+}
+
 // Comment represents a Backlog comment.
 type Comment struct {
 	ID          int          `json:"id"`
 	Content     string       `json:"content"`
+	ChangeLog   []ChangeLog  `json:"changeLog"`
 	CreatedUser *CommentUser `json:"createdUser"`
 	Created     string       `json:"created"`
+	Updated     string       `json:"updated"`
+}
+
+// ChangeLog represents a field change recorded with a comment.
+type ChangeLog struct {
+	Field          string          `json:"field"`
+	NewValue       string          `json:"newValue"`
+	OriginalValue  string          `json:"originalValue"`
+	AttachmentInfo *AttachmentInfo `json:"attachmentInfo"`
+}
+
+// AttachmentInfo identifies the attachment added by a change log entry.
+type AttachmentInfo struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
 }
 
 // CommentUser represents the user who created a comment.
 type CommentUser struct {
+	UserID      string `json:"userId"`
 	Name        string `json:"name"`
 	MailAddress string `json:"mailAddress"`
 }
@@ -332,6 +660,42 @@ func ParseComment(data []byte) (*Comment, error) {
 	return &comment, nil
 }
 
+// CommentNotification represents a notification sent for a comment.
+type CommentNotification struct {
+	ID                  int  `json:"id"`
+	AlreadyRead         bool `json:"alreadyRead"`
+	Reason              int  `json:"reason"`
+	User                User `json:"user"`
+	ResourceAlreadyRead bool `json:"resourceAlreadyRead"`
+}
+
+// ParseCommentNotifications parses the JSON response into a slice of
+// CommentNotification structs.
+func ParseCommentNotifications(data []byte) ([]CommentNotification, error) {
+	var notifications []CommentNotification
+	if err := json.Unmarshal(data, &notifications); err != nil {
+		return nil, fmt.Errorf("failed to parse notifications: %w", err)
+	}
+	return notifications, nil
+}
+
+// FormatCommentNotificationsMarkdown formats the notifications of a comment
+// as Markdown, one notified user per line.
+func FormatCommentNotificationsMarkdown(notifications []CommentNotification) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Notified\n")
+	for _, notification := range notifications {
+		read := "unread"
+		if notification.AlreadyRead {
+			read = "read"
+		}
+		fmt.Fprintf(&sb, "- %s (%s)\n", notification.User.Name, read)
+	}
+
+	return sb.String()
+}
+
 // ParseComments parses the JSON response into a slice of Comment structs.
 func ParseComments(data []byte) ([]Comment, error) {
 	var comments []Comment
@@ -354,12 +718,29 @@ func FormatCommentMarkdown(comment *Comment) string {
 		sb.WriteString("(unknown)\n\n")
 	}
 
-	fmt.Fprintf(&sb, "**Datetime:** %s\n\n", comment.Created)
+	fmt.Fprintf(&sb, "**Datetime:** %s\n\n", locale.DateTimeString(comment.Created))
+
+	edited := comment.Updated != "" && comment.Updated != comment.Created
+	if edited {
+		fmt.Fprintf(&sb, "**Edited:** %s\n\n", locale.DateTimeString(comment.Updated))
+	}
+
+	if len(comment.ChangeLog) > 0 {
+		sb.WriteString("**Changes:**\n")
+		for _, change := range comment.ChangeLog {
+			fmt.Fprintf(&sb, "- %s: %s → %s\n", change.Field, valueOrEmpty(change.OriginalValue), valueOrEmpty(change.NewValue))
+		}
+		sb.WriteString("\n")
+	}
 
 	sb.WriteString("**Content:**\n")
-	if comment.Content != "" {
+	switch {
+	case comment.Content != "":
 		sb.WriteString(comment.Content)
-	} else {
+	case edited:
+		// Deleting a comment that recorded changes only clears its text
+		sb.WriteString("(content deleted)")
+	default:
 		sb.WriteString("(no content)")
 	}
 	sb.WriteString("\n")
@@ -367,6 +748,14 @@ func FormatCommentMarkdown(comment *Comment) string {
 	return sb.String()
 }
 
+// valueOrEmpty returns s, or "(empty)" if s is empty.
+func valueOrEmpty(s string) string {
+	if s == "" {
+		return "(empty)"
+	}
+	return s
+}
+
 // FormatCommentsMarkdown formats a list of comments as Markdown.
 func FormatCommentsMarkdown(comments []Comment) string {
 	var sb strings.Builder
@@ -411,12 +800,80 @@ func FormatProjectStatusesMarkdown(statuses []ProjectStatus) string {
 
 	sb.WriteString("## Status\n")
 	for _, status := range statuses {
-		fmt.Fprintf(&sb, "- %s (id: %d)\n", status.Name, status.ID)
+		fmt.Fprintf(&sb, "- %s (id: %d, color: %s)\n", status.Name, status.ID, status.Color)
 	}
 
 	return sb.String()
 }
 
+// StatusColors lists the colors the API accepts for statuses.
+var StatusColors = []string{
+	"#ea2c00", "#e87758", "#e07b9a", "#868cb7", "#3b9dbd",
+	"#4caf93", "#b0be3c", "#eda62a", "#f42858", "#393939",
+}
+
+// AddStatus adds a status to a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/add-status/
+func (c *Client) AddStatus(projectIDOrKey string, name string, color string) ([]byte, error) {
+	data := url.Values{}
+	data.Set("name", name)
+	data.Set("color", color)
+	return c.doPostRequest("/api/v2/projects/"+projectIDOrKey+"/statuses", data)
+}
+
+// UpdateStatus updates a status's name or color. Empty values are left
+// unchanged.
+// ref: https://developer.nulab.com/docs/backlog/api/2/update-status/
+func (c *Client) UpdateStatus(projectIDOrKey string, statusID int, name string, color string) ([]byte, error) {
+	data := url.Values{}
+	if name != "" {
+		data.Set("name", name)
+	}
+	if color != "" {
+		data.Set("color", color)
+	}
+	return c.doPatchRequest("/api/v2/projects/"+projectIDOrKey+"/statuses/"+strconv.Itoa(statusID), data)
+}
+
+// DeleteStatus deletes a status. Issues in the deleted status are changed
+// to the substitute status.
+// ref: https://developer.nulab.com/docs/backlog/api/2/delete-status/
+func (c *Client) DeleteStatus(projectIDOrKey string, statusID int, substituteStatusID int) ([]byte, error) {
+	data := url.Values{}
+	data.Set("substituteStatusId", strconv.Itoa(substituteStatusID))
+	return c.doDeleteRequest("/api/v2/projects/"+projectIDOrKey+"/statuses/"+strconv.Itoa(statusID), data)
+}
+
+// UpdateStatusOrder sets the display order of a project's statuses. The
+// IDs must list every status of the project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/update-order-of-status/
+func (c *Client) UpdateStatusOrder(projectIDOrKey string, statusIDs []int) ([]byte, error) {
+	data := url.Values{}
+	for _, id := range statusIDs {
+		data.Add("statusId[]", strconv.Itoa(id))
+	}
+	return c.doPatchRequest("/api/v2/projects/"+projectIDOrKey+"/statuses/updateDisplayOrder", data)
+}
+
+// ParseProjectStatus parses the JSON response into a ProjectStatus struct.
+func ParseProjectStatus(data []byte) (*ProjectStatus, error) {
+	var status ProjectStatus
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, fmt.Errorf("failed to parse status: %w", err)
+	}
+	return &status, nil
+}
+
+// FindProjectStatus finds a status by numeric ID or name (case-insensitive).
+func FindProjectStatus(statuses []ProjectStatus, query string) (*ProjectStatus, error) {
+	for i, status := range statuses {
+		if strconv.Itoa(status.ID) == query || strings.EqualFold(status.Name, query) {
+			return &statuses[i], nil
+		}
+	}
+	return nil, fmt.Errorf("status not found: %s", query)
+}
+
 // GetCategories retrieves the category list for a project.
 // ref: https://developer.nulab.com/docs/backlog/api/2/get-category-list/
 func (c *Client) GetCategories(projectIDOrKey string) ([]byte, error) {
@@ -452,6 +909,47 @@ func FormatCategoriesMarkdown(categories []Category) string {
 	return sb.String()
 }
 
+// AddCategory adds a category to a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/add-category/
+func (c *Client) AddCategory(projectIDOrKey string, name string) ([]byte, error) {
+	data := url.Values{}
+	data.Set("name", name)
+	return c.doPostRequest("/api/v2/projects/"+projectIDOrKey+"/categories", data)
+}
+
+// UpdateCategory renames a category.
+// ref: https://developer.nulab.com/docs/backlog/api/2/update-category/
+func (c *Client) UpdateCategory(projectIDOrKey string, categoryID int, name string) ([]byte, error) {
+	data := url.Values{}
+	data.Set("name", name)
+	return c.doPatchRequest("/api/v2/projects/"+projectIDOrKey+"/categories/"+strconv.Itoa(categoryID), data)
+}
+
+// DeleteCategory deletes a category. Issues keep their other categories.
+// ref: https://developer.nulab.com/docs/backlog/api/2/delete-category/
+func (c *Client) DeleteCategory(projectIDOrKey string, categoryID int) ([]byte, error) {
+	return c.doRequest("DELETE", "/api/v2/projects/"+projectIDOrKey+"/categories/"+strconv.Itoa(categoryID))
+}
+
+// ParseCategory parses the JSON response into a Category struct.
+func ParseCategory(data []byte) (*Category, error) {
+	var category Category
+	if err := json.Unmarshal(data, &category); err != nil {
+		return nil, fmt.Errorf("failed to parse category: %w", err)
+	}
+	return &category, nil
+}
+
+// FindCategory finds a category by numeric ID or name (case-insensitive).
+func FindCategory(categories []Category, query string) (*Category, error) {
+	for i, category := range categories {
+		if strconv.Itoa(category.ID) == query || strings.EqualFold(category.Name, query) {
+			return &categories[i], nil
+		}
+	}
+	return nil, fmt.Errorf("category not found: %s", query)
+}
+
 // GetVersions retrieves the version/milestone list for a project.
 // ref: https://developer.nulab.com/docs/backlog/api/2/get-version-milestone-list/
 func (c *Client) GetVersions(projectIDOrKey string) ([]byte, error) {
@@ -495,10 +993,10 @@ func FormatVersionsMarkdown(versions []Version) string {
 	for _, version := range versions {
 		fmt.Fprintf(&sb, "- %s (id: %d)", version.Name, version.ID)
 		if version.StartDate != "" {
-			fmt.Fprintf(&sb, ", start: %s", formatDate(version.StartDate))
+			fmt.Fprintf(&sb, ", start: %s", locale.DateString(version.StartDate))
 		}
 		if version.ReleaseDueDate != "" {
-			fmt.Fprintf(&sb, ", due: %s", formatDate(version.ReleaseDueDate))
+			fmt.Fprintf(&sb, ", due: %s", locale.DateString(version.ReleaseDueDate))
 		}
 		if version.Archived {
 			sb.WriteString(", archived")
@@ -509,6 +1007,40 @@ func FormatVersionsMarkdown(versions []Version) string {
 	return sb.String()
 }
 
+// AddVersion adds a version/milestone to a project. The data must set name
+// and may set description, startDate, and releaseDueDate.
+// ref: https://developer.nulab.com/docs/backlog/api/2/add-version-milestone/
+func (c *Client) AddVersion(projectIDOrKey string, data url.Values) ([]byte, error) {
+	return c.doPostRequest("/api/v2/projects/"+projectIDOrKey+"/versions", data)
+}
+
+// UpdateVersion updates a version/milestone. The data must set name and may
+// set description, startDate, releaseDueDate, and archived.
+// ref: https://developer.nulab.com/docs/backlog/api/2/update-version-milestone/
+func (c *Client) UpdateVersion(projectIDOrKey string, versionID int, data url.Values) ([]byte, error) {
+	return c.doPatchRequest("/api/v2/projects/"+projectIDOrKey+"/versions/"+strconv.Itoa(versionID), data)
+}
+
+// ParseVersion parses the JSON response into a Version struct.
+func ParseVersion(data []byte) (*Version, error) {
+	var version Version
+	if err := json.Unmarshal(data, &version); err != nil {
+		return nil, fmt.Errorf("failed to parse version: %w", err)
+	}
+	return &version, nil
+}
+
+// FindVersion finds a version/milestone by numeric ID or name
+// (case-insensitive).
+func FindVersion(versions []Version, query string) (*Version, error) {
+	for i, version := range versions {
+		if strconv.Itoa(version.ID) == query || strings.EqualFold(version.Name, query) {
+			return &versions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("version/milestone not found: %s", query)
+}
+
 // GetIssueTypes retrieves the issue type list for a project.
 // ref: https://developer.nulab.com/docs/backlog/api/2/get-issue-type-list/
 func (c *Client) GetIssueTypes(projectIDOrKey string) ([]byte, error) {
@@ -539,25 +1071,68 @@ func FormatIssueTypesMarkdown(issueTypes []IssueType) string {
 
 	sb.WriteString("## Issue Type\n")
 	for _, issueType := range issueTypes {
-		fmt.Fprintf(&sb, "- %s (id: %d)\n", issueType.Name, issueType.ID)
+		fmt.Fprintf(&sb, "- %s (id: %d, color: %s)\n", issueType.Name, issueType.ID, issueType.Color)
 	}
 
 	return sb.String()
 }
 
-// GetPriorities retrieves the priority list.
-// ref: https://developer.nulab.com/docs/backlog/api/2/get-priority-list/
-func (c *Client) GetPriorities() ([]byte, error) {
-	return c.doRequest("GET", "/api/v2/priorities")
+// IssueTypeColors lists the colors the API accepts for issue types.
+var IssueTypeColors = []string{
+	"#e30000", "#990000", "#934981", "#814fbc", "#2779ca",
+	"#007e9a", "#7ea800", "#ff9200", "#ff3265", "#666665",
 }
 
-// Priority represents a priority in Backlog.
-type Priority struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+// AddIssueType adds an issue type to a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/add-issue-type/
+func (c *Client) AddIssueType(projectIDOrKey string, name string, color string) ([]byte, error) {
+	data := url.Values{}
+	data.Set("name", name)
+	data.Set("color", color)
+	return c.doPostRequest("/api/v2/projects/"+projectIDOrKey+"/issueTypes", data)
 }
 
-// ParsePriorities parses the JSON response into a slice of Priority structs.
+// DeleteIssueType deletes an issue type from a project. Issues of the
+// deleted type are changed to the substitute issue type.
+// ref: https://developer.nulab.com/docs/backlog/api/2/delete-issue-type/
+func (c *Client) DeleteIssueType(projectIDOrKey string, issueTypeID int, substituteIssueTypeID int) ([]byte, error) {
+	data := url.Values{}
+	data.Set("substituteIssueTypeId", strconv.Itoa(substituteIssueTypeID))
+	return c.doDeleteRequest("/api/v2/projects/"+projectIDOrKey+"/issueTypes/"+strconv.Itoa(issueTypeID), data)
+}
+
+// ParseIssueType parses the JSON response into an IssueType struct.
+func ParseIssueType(data []byte) (*IssueType, error) {
+	var issueType IssueType
+	if err := json.Unmarshal(data, &issueType); err != nil {
+		return nil, fmt.Errorf("failed to parse issue type: %w", err)
+	}
+	return &issueType, nil
+}
+
+// FindIssueType finds an issue type by numeric ID or name (case-insensitive).
+func FindIssueType(issueTypes []IssueType, query string) (*IssueType, error) {
+	for i, issueType := range issueTypes {
+		if strconv.Itoa(issueType.ID) == query || strings.EqualFold(issueType.Name, query) {
+			return &issueTypes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("issue type not found: %s", query)
+}
+
+// GetPriorities retrieves the priority list.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-priority-list/
+func (c *Client) GetPriorities() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/priorities")
+}
+
+// Priority represents a priority in Backlog.
+type Priority struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ParsePriorities parses the JSON response into a slice of Priority structs.
 func ParsePriorities(data []byte) ([]Priority, error) {
 	var priorities []Priority
 	if err := json.Unmarshal(data, &priorities); err != nil {
@@ -566,6 +1141,54 @@ func ParsePriorities(data []byte) ([]Priority, error) {
 	return priorities, nil
 }
 
+// FormatPrioritiesMarkdown formats a list of priorities as Markdown.
+func FormatPrioritiesMarkdown(priorities []Priority) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Priority\n")
+	for _, priority := range priorities {
+		fmt.Fprintf(&sb, "- %s (id: %d)\n", priority.Name, priority.ID)
+	}
+
+	return sb.String()
+}
+
+// GetResolutions retrieves the resolution list.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-resolution-list/
+func (c *Client) GetResolutions() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/resolutions")
+}
+
+// Resolution represents a resolution in Backlog.
+type Resolution struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ParseResolutions parses the JSON response into a slice of Resolution structs.
+func ParseResolutions(data []byte) ([]Resolution, error) {
+	var resolutions []Resolution
+	if err := json.Unmarshal(data, &resolutions); err != nil {
+		return nil, fmt.Errorf("failed to parse resolutions: %w", err)
+	}
+	return resolutions, nil
+}
+
+// FormatResolutionsMarkdown formats a list of resolutions as a Markdown
+// table of IDs and names.
+func FormatResolutionsMarkdown(resolutions []Resolution) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Resolution\n")
+	sb.WriteString("| ID | Name |\n")
+	sb.WriteString("|---:|------|\n")
+	for _, resolution := range resolutions {
+		fmt.Fprintf(&sb, "| %d | %s |\n", resolution.ID, resolution.Name)
+	}
+
+	return sb.String()
+}
+
 // GetIssueAttachments retrieves the attachment list for an issue.
 // ref: https://developer.nulab.com/docs/backlog/api/2/get-list-of-issue-attachments/
 func (c *Client) GetIssueAttachments(issueKeyOrID string) ([]byte, error) {
@@ -577,7 +1200,13 @@ func (c *Client) GetIssueAttachments(issueKeyOrID string) ([]byte, error) {
 // header (empty string if the header has no filename).
 // ref: https://developer.nulab.com/docs/backlog/api/2/get-issue-attachment/
 func (c *Client) DownloadIssueAttachment(issueKeyOrID string, attachmentID string) ([]byte, string, error) {
-	path := "/api/v2/issues/" + issueKeyOrID + "/attachments/" + attachmentID
+	return c.doDownload("/api/v2/issues/" + issueKeyOrID + "/attachments/" + attachmentID)
+}
+
+// doDownload performs an HTTP GET request for a file. It returns the file
+// content and the filename from the Content-Disposition header (empty
+// string if the header has no filename).
+func (c *Client) doDownload(path string) ([]byte, string, error) {
 	url := fmt.Sprintf("https://%s%s", c.cfg.Space, path)
 
 	req, err := http.NewRequest("GET", url, nil)
@@ -585,9 +1214,9 @@ func (c *Client) DownloadIssueAttachment(issueKeyOrID string, attachmentID strin
 		return nil, "", err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.cfg.AccessToken)
+	c.authorize(req)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, "", err
 	}
@@ -612,7 +1241,7 @@ func (c *Client) DownloadIssueAttachment(issueKeyOrID string, attachmentID strin
 				return nil, "", fmt.Errorf("failed to reload config: %w", err)
 			}
 			c.cfg = cfg
-			return c.DownloadIssueAttachment(issueKeyOrID, attachmentID)
+			return c.doDownload(path)
 		}
 		if strings.Contains(wwwAuth, "The access token is invalid") {
 			return nil, "", fmt.Errorf("access token is invalid. Please run 'bgl auth login'")
@@ -636,9 +1265,11 @@ func (c *Client) DownloadIssueAttachment(issueKeyOrID string, attachmentID strin
 
 // Attachment represents an attachment file on a Backlog issue.
 type Attachment struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
-	Size int64  `json:"size"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	CreatedUser *User  `json:"createdUser"`
+	Created     string `json:"created"`
 }
 
 // ParseAttachments parses the JSON response into a slice of Attachment structs.
@@ -656,7 +1287,7 @@ func FormatAttachmentsMarkdown(attachments []Attachment) string {
 
 	sb.WriteString("## Attachment\n")
 	for _, attachment := range attachments {
-		fmt.Fprintf(&sb, "- %s (id: %d, size: %d bytes)\n", attachment.Name, attachment.ID, attachment.Size)
+		fmt.Fprintf(&sb, "- %s (id: %d, size: %s bytes)\n", attachment.Name, attachment.ID, locale.Number(attachment.Size))
 	}
 
 	return sb.String()
@@ -668,11 +1299,42 @@ func (c *Client) GetProject(projectIDOrKey string) ([]byte, error) {
 	return c.doRequest("GET", "/api/v2/projects/"+projectIDOrKey)
 }
 
+// AddProject creates a project. The data must set name, key, chartEnabled,
+// subtaskingEnabled, and textFormattingRule.
+// ref: https://developer.nulab.com/docs/backlog/api/2/add-project/
+func (c *Client) AddProject(data url.Values) ([]byte, error) {
+	return c.doPostRequest("/api/v2/projects", data)
+}
+
+// UpdateProject updates a project's name, key, settings, or archived flag.
+// ref: https://developer.nulab.com/docs/backlog/api/2/update-project/
+func (c *Client) UpdateProject(projectIDOrKey string, data url.Values) ([]byte, error) {
+	return c.doPatchRequest("/api/v2/projects/"+projectIDOrKey, data)
+}
+
+// GetProjects retrieves the list of projects the user can access.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-project-list/
+func (c *Client) GetProjects() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/projects")
+}
+
 // Project represents a Backlog project.
 type Project struct {
-	ID         int    `json:"id"`
-	ProjectKey string `json:"projectKey"`
-	Name       string `json:"name"`
+	ID                                int    `json:"id"`
+	ProjectKey                        string `json:"projectKey"`
+	Name                              string `json:"name"`
+	ChartEnabled                      bool   `json:"chartEnabled"`
+	UseResolvedForChart               bool   `json:"useResolvedForChart"`
+	SubtaskingEnabled                 bool   `json:"subtaskingEnabled"`
+	ProjectLeaderCanEditProjectLeader bool   `json:"projectLeaderCanEditProjectLeader"`
+	UseWiki                           bool   `json:"useWiki"`
+	UseFileSharing                    bool   `json:"useFileSharing"`
+	UseWikiTreeView                   bool   `json:"useWikiTreeView"`
+	UseOriginalImageSizeAtWiki        bool   `json:"useOriginalImageSizeAtWiki"`
+	UseDevAttributes                  bool   `json:"useDevAttributes"`
+	TextFormattingRule                string `json:"textFormattingRule"`
+	Archived                          bool   `json:"archived"`
+	DisplayOrder                      int    `json:"displayOrder"`
 }
 
 // ParseProject parses the JSON response into a Project struct.
@@ -683,3 +1345,1563 @@ func ParseProject(data []byte) (*Project, error) {
 	}
 	return &project, nil
 }
+
+// ParseProjects parses the JSON response into a slice of Project structs.
+func ParseProjects(data []byte) ([]Project, error) {
+	var projects []Project
+	if err := json.Unmarshal(data, &projects); err != nil {
+		return nil, fmt.Errorf("failed to parse projects: %w", err)
+	}
+	return projects, nil
+}
+
+// FormatProjectsMarkdown formats a list of projects as a Markdown table.
+func FormatProjectsMarkdown(projects []Project) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Project\n\n")
+	sb.WriteString("| Key | Name | Archived |\n")
+	sb.WriteString("|-----|------|----------|\n")
+	for _, project := range projects {
+		archived := ""
+		if project.Archived {
+			archived = "yes"
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s |\n", project.ProjectKey, escapeTableCell(project.Name), archived)
+	}
+
+	return sb.String()
+}
+
+// FormatProjectMarkdown formats a project's settings as Markdown.
+func FormatProjectMarkdown(project *Project) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s\n\n", project.Name)
+	fmt.Fprintf(&sb, "**Key:** %s\n\n", project.ProjectKey)
+	fmt.Fprintf(&sb, "**ID:** %d\n\n", project.ID)
+	fmt.Fprintf(&sb, "**Text Formatting Rule:** %s\n\n", project.TextFormattingRule)
+	fmt.Fprintf(&sb, "**Archived:** %s\n\n", yesNo(project.Archived))
+
+	sb.WriteString("## Settings\n")
+	fmt.Fprintf(&sb, "- Charts (Gantt/burndown): %s\n", yesNo(project.ChartEnabled))
+	fmt.Fprintf(&sb, "- Count resolved issues as done in charts: %s\n", yesNo(project.UseResolvedForChart))
+	fmt.Fprintf(&sb, "- Subtasking: %s\n", yesNo(project.SubtaskingEnabled))
+	fmt.Fprintf(&sb, "- Wiki: %s\n", yesNo(project.UseWiki))
+	fmt.Fprintf(&sb, "- Wiki tree view: %s\n", yesNo(project.UseWikiTreeView))
+	fmt.Fprintf(&sb, "- File sharing: %s\n", yesNo(project.UseFileSharing))
+	fmt.Fprintf(&sb, "- Development attributes: %s\n", yesNo(project.UseDevAttributes))
+	fmt.Fprintf(&sb, "- Project leaders can edit project leaders: %s\n", yesNo(project.ProjectLeaderCanEditProjectLeader))
+
+	return sb.String()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// escapeTableCell escapes pipes so a value stays in its Markdown table cell.
+func escapeTableCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
+
+// GetCustomFields retrieves the custom field list for a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-custom-field-list/
+func (c *Client) GetCustomFields(projectIDOrKey string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/projects/"+projectIDOrKey+"/customFields")
+}
+
+// CustomField represents a custom field of a Backlog project. Items are the
+// allowed values of list, checkbox, and radio fields.
+type CustomField struct {
+	ID          int               `json:"id"`
+	TypeID      int               `json:"typeId"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Required    bool              `json:"required"`
+	Items       []CustomFieldItem `json:"items"`
+}
+
+// CustomFieldItem is an allowed value of a custom field.
+type CustomFieldItem struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	DisplayOrder int    `json:"displayOrder"`
+}
+
+// customFieldTypes names the custom field type IDs.
+var customFieldTypes = map[int]string{
+	1: "Text",
+	2: "Sentence",
+	3: "Number",
+	4: "Date",
+	5: "Single list",
+	6: "Multiple list",
+	7: "Checkbox",
+	8: "Radio",
+}
+
+// TypeName returns the name of the custom field's type.
+func (f CustomField) TypeName() string {
+	if name, ok := customFieldTypes[f.TypeID]; ok {
+		return name
+	}
+	return fmt.Sprintf("type %d", f.TypeID)
+}
+
+// ParseCustomFields parses the JSON response into a slice of CustomField structs.
+func ParseCustomFields(data []byte) ([]CustomField, error) {
+	var fields []CustomField
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse custom fields: %w", err)
+	}
+	return fields, nil
+}
+
+// FormatCustomFieldsMarkdown formats a list of custom fields as a Markdown
+// table, listing allowed values as "name (id)".
+func FormatCustomFieldsMarkdown(fields []CustomField) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Custom Fields\n")
+	if len(fields) == 0 {
+		sb.WriteString("\nNo custom fields.\n")
+		return sb.String()
+	}
+	sb.WriteString("| ID | Name | Type | Required | Allowed Values |\n")
+	sb.WriteString("|----|------|------|----------|----------------|\n")
+	for _, field := range fields {
+		items := make([]string, 0, len(field.Items))
+		for _, item := range field.Items {
+			items = append(items, fmt.Sprintf("%s (%d)", item.Name, item.ID))
+		}
+		fmt.Fprintf(&sb, "| %d | %s | %s | %s | %s |\n", field.ID, escapeTableCell(field.Name), field.TypeName(),
+			yesNo(field.Required), escapeTableCell(strings.Join(items, ", ")))
+	}
+
+	return sb.String()
+}
+
+// GetMyself retrieves the authenticated user.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-own-user/
+func (c *Client) GetMyself() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/users/myself")
+}
+
+// User represents a Backlog user.
+type User struct {
+	ID            int    `json:"id"`
+	UserID        string `json:"userId"`
+	Name          string `json:"name"`
+	RoleType      int    `json:"roleType"`
+	Lang          string `json:"lang"`
+	MailAddress   string `json:"mailAddress"`
+	LastLoginTime string `json:"lastLoginTime"`
+}
+
+// ParseUser parses the JSON response into a User struct.
+func ParseUser(data []byte) (*User, error) {
+	var user User
+	if err := json.Unmarshal(data, &user); err != nil {
+		return nil, fmt.Errorf("failed to parse user: %w", err)
+	}
+	return &user, nil
+}
+
+// RoleName returns the display name of a user role type.
+func RoleName(roleType int) string {
+	switch roleType {
+	case 1:
+		return "Administrator"
+	case 2:
+		return "Normal User"
+	case 3:
+		return "Reporter"
+	case 4:
+		return "Viewer"
+	case 5:
+		return "Guest Reporter"
+	case 6:
+		return "Guest Viewer"
+	default:
+		return fmt.Sprintf("Unknown (%d)", roleType)
+	}
+}
+
+// FormatUserMarkdown formats a user as Markdown.
+func FormatUserMarkdown(user *User) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "## %s\n", user.Name)
+	fmt.Fprintf(&sb, "- ID: %d\n", user.ID)
+	fmt.Fprintf(&sb, "- User ID: %s\n", user.UserID)
+	fmt.Fprintf(&sb, "- Mail: %s\n", user.MailAddress)
+	fmt.Fprintf(&sb, "- Role: %s\n", RoleName(user.RoleType))
+	if user.Lang != "" {
+		fmt.Fprintf(&sb, "- Language: %s\n", user.Lang)
+	}
+	if user.LastLoginTime != "" {
+		fmt.Fprintf(&sb, "- Last login: %s\n", locale.DateTimeString(user.LastLoginTime))
+	}
+
+	return sb.String()
+}
+
+// GetGitRepositories retrieves the Git repository list for a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-list-of-git-repositories/
+func (c *Client) GetGitRepositories(projectIDOrKey string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/projects/"+projectIDOrKey+"/git/repositories")
+}
+
+// Repository represents a Git repository in a Backlog project.
+type Repository struct {
+	ID          int    `json:"id"`
+	ProjectID   int    `json:"projectId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	HTTPURL     string `json:"httpUrl"`
+	SSHURL      string `json:"sshUrl"`
+	PushedAt    string `json:"pushedAt"`
+	Created     string `json:"created"`
+	Updated     string `json:"updated"`
+}
+
+// PullRequestURL returns the web URL of a pull request in a repository.
+func PullRequestURL(repo *Repository, number int) string {
+	return fmt.Sprintf("%s/pullRequests/%d", strings.TrimSuffix(repo.HTTPURL, ".git"), number)
+}
+
+// ParseRepositories parses the JSON response into a slice of Repository structs.
+func ParseRepositories(data []byte) ([]Repository, error) {
+	var repositories []Repository
+	if err := json.Unmarshal(data, &repositories); err != nil {
+		return nil, fmt.Errorf("failed to parse repositories: %w", err)
+	}
+	return repositories, nil
+}
+
+// GetGitRepository retrieves a Git repository by its ID or name.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-git-repository/
+func (c *Client) GetGitRepository(projectIDOrKey string, repoIDOrName string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/projects/"+projectIDOrKey+"/git/repositories/"+url.PathEscape(repoIDOrName))
+}
+
+// ParseRepository parses the JSON response into a Repository struct.
+func ParseRepository(data []byte) (*Repository, error) {
+	var repository Repository
+	if err := json.Unmarshal(data, &repository); err != nil {
+		return nil, fmt.Errorf("failed to parse repository: %w", err)
+	}
+	return &repository, nil
+}
+
+// FormatRepositoryMarkdown formats a Git repository's details as Markdown.
+func FormatRepositoryMarkdown(repository *Repository) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s\n\n", repository.Name)
+	fmt.Fprintf(&sb, "**ID:** %d\n\n", repository.ID)
+	if repository.Description != "" {
+		fmt.Fprintf(&sb, "%s\n\n", repository.Description)
+	}
+
+	sb.WriteString("## Clone\n")
+	fmt.Fprintf(&sb, "- HTTPS: `%s`\n", repository.HTTPURL)
+	fmt.Fprintf(&sb, "- SSH: `%s`\n", repository.SSHURL)
+	sb.WriteString("\n")
+
+	sb.WriteString("## Activity\n")
+	if repository.PushedAt != "" {
+		fmt.Fprintf(&sb, "- Last push: %s\n", locale.DateTimeString(repository.PushedAt))
+	} else {
+		sb.WriteString("- Last push: (never)\n")
+	}
+	fmt.Fprintf(&sb, "- Created: %s\n", locale.DateTimeString(repository.Created))
+	fmt.Fprintf(&sb, "- Updated: %s\n", locale.DateTimeString(repository.Updated))
+
+	return sb.String()
+}
+
+// FormatRepositoriesMarkdown formats a list of Git repositories as Markdown.
+func FormatRepositoriesMarkdown(repositories []Repository) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Repository\n")
+	if len(repositories) == 0 {
+		sb.WriteString("\nNo repositories.\n")
+		return sb.String()
+	}
+	for _, repository := range repositories {
+		fmt.Fprintf(&sb, "- %s (id: %d)\n", repository.Name, repository.ID)
+		if repository.Description != "" {
+			fmt.Fprintf(&sb, "  - Description: %s\n", repository.Description)
+		}
+		fmt.Fprintf(&sb, "  - HTTPS: `%s`\n", repository.HTTPURL)
+		fmt.Fprintf(&sb, "  - SSH: `%s`\n", repository.SSHURL)
+	}
+
+	return sb.String()
+}
+
+// GetPullRequests retrieves the pull request list for a repository.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-pull-request-list/
+func (c *Client) GetPullRequests(projectIDOrKey string, repoIDOrName string, query url.Values) ([]byte, error) {
+	path := "/api/v2/projects/" + projectIDOrKey + "/git/repositories/" + url.PathEscape(repoIDOrName) + "/pullRequests"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// PullRequest represents a pull request in a Backlog Git repository.
+type PullRequest struct {
+	ID           int                `json:"id"`
+	ProjectID    int                `json:"projectId"`
+	RepositoryID int                `json:"repositoryId"`
+	Number       int                `json:"number"`
+	Summary      string             `json:"summary"`
+	Description  string             `json:"description"`
+	Base         string             `json:"base"`
+	Branch       string             `json:"branch"`
+	Status       *PullRequestStatus `json:"status"`
+	Assignee     *User              `json:"assignee"`
+	Issue        *Issue             `json:"issue"`
+	CreatedUser  *User              `json:"createdUser"`
+	Created      string             `json:"created"`
+	Updated      string             `json:"updated"`
+	CloseAt      string             `json:"closeAt"`
+	MergeAt      string             `json:"mergeAt"`
+}
+
+// PullRequestStatus represents the status of a pull request.
+type PullRequestStatus struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ParsePullRequests parses the JSON response into a slice of PullRequest structs.
+func ParsePullRequests(data []byte) ([]PullRequest, error) {
+	var pullRequests []PullRequest
+	if err := json.Unmarshal(data, &pullRequests); err != nil {
+		return nil, fmt.Errorf("failed to parse pull requests: %w", err)
+	}
+	return pullRequests, nil
+}
+
+// GetPullRequest retrieves a pull request by its number.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-pull-request/
+func (c *Client) GetPullRequest(projectIDOrKey string, repoIDOrName string, number string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/projects/"+projectIDOrKey+"/git/repositories/"+url.PathEscape(repoIDOrName)+"/pullRequests/"+number)
+}
+
+// ParsePullRequest parses the JSON response into a PullRequest struct.
+func ParsePullRequest(data []byte) (*PullRequest, error) {
+	var pr PullRequest
+	if err := json.Unmarshal(data, &pr); err != nil {
+		return nil, fmt.Errorf("failed to parse pull request: %w", err)
+	}
+	return &pr, nil
+}
+
+// FormatPullRequestMarkdown formats a pull request as Markdown.
+func FormatPullRequestMarkdown(pr *PullRequest) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# #%d %s\n\n", pr.Number, pr.Summary)
+
+	sb.WriteString("## Metadata\n")
+	if pr.Status != nil {
+		fmt.Fprintf(&sb, "- Status: %s\n", pr.Status.Name)
+	} else {
+		sb.WriteString("- Status: (unknown)\n")
+	}
+	fmt.Fprintf(&sb, "- Branch: `%s` → `%s`\n", pr.Branch, pr.Base)
+	if pr.Assignee != nil {
+		fmt.Fprintf(&sb, "- Assignee: %s\n", pr.Assignee.Name)
+	} else {
+		sb.WriteString("- Assignee: (unassigned)\n")
+	}
+	if pr.Issue != nil && pr.Issue.IssueKey != "" {
+		fmt.Fprintf(&sb, "- Issue: %s %s\n", pr.Issue.IssueKey, pr.Issue.Summary)
+	}
+	if pr.CreatedUser != nil {
+		fmt.Fprintf(&sb, "- Created: %s by %s\n", locale.DateTimeString(pr.Created), pr.CreatedUser.Name)
+	}
+	if pr.MergeAt != "" {
+		fmt.Fprintf(&sb, "- Merged: %s\n", locale.DateTimeString(pr.MergeAt))
+	} else if pr.CloseAt != "" {
+		fmt.Fprintf(&sb, "- Closed: %s\n", locale.DateTimeString(pr.CloseAt))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## Description\n\n")
+	if pr.Description != "" {
+		sb.WriteString(pr.Description)
+	} else {
+		sb.WriteString("(no description)")
+	}
+	sb.WriteString("\n")
+
+	return sb.String()
+}
+
+// AddPullRequest creates a pull request. The data must set summary,
+// description, base, and branch, and may set issueId and assigneeId.
+// ref: https://developer.nulab.com/docs/backlog/api/2/add-pull-request/
+func (c *Client) AddPullRequest(projectIDOrKey string, repoIDOrName string, data url.Values) ([]byte, error) {
+	return c.doPostRequest("/api/v2/projects/"+projectIDOrKey+"/git/repositories/"+url.PathEscape(repoIDOrName)+"/pullRequests", data)
+}
+
+// UpdatePullRequest updates a pull request. The data may set summary,
+// description, issueId, assigneeId, and comment. The API cannot change a
+// pull request's status.
+// ref: https://developer.nulab.com/docs/backlog/api/2/update-pull-request/
+func (c *Client) UpdatePullRequest(projectIDOrKey string, repoIDOrName string, number string, data url.Values) ([]byte, error) {
+	return c.doPatchRequest("/api/v2/projects/"+projectIDOrKey+"/git/repositories/"+url.PathEscape(repoIDOrName)+"/pullRequests/"+number, data)
+}
+
+// GetPullRequestAttachments retrieves the attachment list of a pull request.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-list-of-pull-request-attachment/
+func (c *Client) GetPullRequestAttachments(projectIDOrKey string, repoIDOrName string, number string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/projects/"+projectIDOrKey+"/git/repositories/"+url.PathEscape(repoIDOrName)+"/pullRequests/"+number+"/attachments")
+}
+
+// DownloadPullRequestAttachment downloads a pull request's attachment file.
+// It returns the file content and the filename from the Content-Disposition
+// header (empty string if the header has no filename).
+// ref: https://developer.nulab.com/docs/backlog/api/2/download-pull-request-attachment/
+func (c *Client) DownloadPullRequestAttachment(projectIDOrKey string, repoIDOrName string, number string, attachmentID string) ([]byte, string, error) {
+	return c.doDownload("/api/v2/projects/" + projectIDOrKey + "/git/repositories/" + url.PathEscape(repoIDOrName) + "/pullRequests/" + number + "/attachments/" + attachmentID)
+}
+
+// GetPullRequestCount retrieves the number of pull requests in a
+// repository. The query takes the same filters as GetPullRequests.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-number-of-pull-requests/
+func (c *Client) GetPullRequestCount(projectIDOrKey string, repoIDOrName string, query url.Values) ([]byte, error) {
+	path := "/api/v2/projects/" + projectIDOrKey + "/git/repositories/" + url.PathEscape(repoIDOrName) + "/pullRequests/count"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// Pull request status IDs.
+const (
+	PullRequestOpen   = 1
+	PullRequestClosed = 2
+	PullRequestMerged = 3
+)
+
+// PullRequestStatusID returns the status ID for a status name (open,
+// closed, or merged; case-insensitive).
+func PullRequestStatusID(name string) (int, error) {
+	switch strings.ToLower(name) {
+	case "open":
+		return PullRequestOpen, nil
+	case "closed":
+		return PullRequestClosed, nil
+	case "merged":
+		return PullRequestMerged, nil
+	}
+	return 0, fmt.Errorf("invalid pull request status %q (expected open, closed, or merged)", name)
+}
+
+// FormatPullRequestsMarkdown formats a list of pull requests as a Markdown
+// table.
+func FormatPullRequestsMarkdown(repoName string, pullRequests []PullRequest) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "## Pull Requests in %s\n\n", repoName)
+	if len(pullRequests) == 0 {
+		sb.WriteString("No pull requests.\n")
+		return sb.String()
+	}
+	sb.WriteString("| # | Title | Branch | Status | Assignee |\n")
+	sb.WriteString("|---|-------|--------|--------|----------|\n")
+	for _, pr := range pullRequests {
+		status := "(unknown)"
+		if pr.Status != nil {
+			status = pr.Status.Name
+		}
+		assignee := ""
+		if pr.Assignee != nil {
+			assignee = pr.Assignee.Name
+		}
+		fmt.Fprintf(&sb, "| %d | %s | %s → %s | %s | %s |\n", pr.Number, escapeTableCell(pr.Summary),
+			escapeTableCell(pr.Branch), escapeTableCell(pr.Base), status, escapeTableCell(assignee))
+	}
+
+	return sb.String()
+}
+
+// FormatPullRequestMarkdownLine formats a pull request as a Markdown list item.
+func FormatPullRequestMarkdownLine(pr *PullRequest) string {
+	status := "(unknown)"
+	if pr.Status != nil {
+		status = pr.Status.Name
+	}
+	return fmt.Sprintf("- #%d %s (%s → %s, %s)\n", pr.Number, pr.Summary, pr.Branch, pr.Base, status)
+}
+
+// AddProjectUser adds a user to a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/add-project-user/
+func (c *Client) AddProjectUser(projectIDOrKey string, userID int) ([]byte, error) {
+	data := url.Values{}
+	data.Set("userId", strconv.Itoa(userID))
+	return c.doPostRequest("/api/v2/projects/"+projectIDOrKey+"/users", data)
+}
+
+// DeleteProjectUser removes a user from a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/delete-project-user/
+func (c *Client) DeleteProjectUser(projectIDOrKey string, userID int) ([]byte, error) {
+	data := url.Values{}
+	data.Set("userId", strconv.Itoa(userID))
+	return c.doDeleteRequest("/api/v2/projects/"+projectIDOrKey+"/users", data)
+}
+
+// GetUsers retrieves the list of users in the space.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-user-list/
+func (c *Client) GetUsers() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/users")
+}
+
+// GetUser retrieves a user by numeric ID.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-user/
+func (c *Client) GetUser(userID int) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/users/"+strconv.Itoa(userID))
+}
+
+// DownloadUserIcon downloads a user's icon image.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-user-icon/
+func (c *Client) DownloadUserIcon(userID int) ([]byte, string, error) {
+	return c.doDownload("/api/v2/users/" + strconv.Itoa(userID) + "/icon")
+}
+
+// GetProjectUsers retrieves the member list of a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-project-user-list/
+func (c *Client) GetProjectUsers(projectIDOrKey string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/projects/"+projectIDOrKey+"/users")
+}
+
+// ParseUsers parses the JSON response into a slice of User structs.
+func ParseUsers(data []byte) ([]User, error) {
+	var users []User
+	if err := json.Unmarshal(data, &users); err != nil {
+		return nil, fmt.Errorf("failed to parse users: %w", err)
+	}
+	return users, nil
+}
+
+// FindUser finds a user by numeric ID, user ID, name, or mail address.
+// Names and mail addresses are matched case-insensitively.
+func FindUser(users []User, query string) (*User, error) {
+	for i, user := range users {
+		if strconv.Itoa(user.ID) == query || user.UserID == query ||
+			strings.EqualFold(user.Name, query) || strings.EqualFold(user.MailAddress, query) {
+			return &users[i], nil
+		}
+	}
+	return nil, fmt.Errorf("user not found: %s", query)
+}
+
+// MatchUser reports whether a user's name, user ID, or mail address
+// contains query, case-insensitively.
+func MatchUser(user *User, query string) bool {
+	query = strings.ToLower(query)
+	return strings.Contains(strings.ToLower(user.Name), query) ||
+		strings.Contains(strings.ToLower(user.UserID), query) ||
+		strings.Contains(strings.ToLower(user.MailAddress), query)
+}
+
+// FormatUsersMarkdown formats a list of users as Markdown.
+func FormatUsersMarkdown(users []User) string {
+	var sb strings.Builder
+
+	sb.WriteString("## User\n")
+	if len(users) == 0 {
+		sb.WriteString("\nNo users.\n")
+		return sb.String()
+	}
+	for _, user := range users {
+		fmt.Fprintf(&sb, "- %s (id: %d, %s)\n", user.Name, user.ID, RoleName(user.RoleType))
+		if user.UserID != "" {
+			fmt.Fprintf(&sb, "  - User ID: %s\n", user.UserID)
+		}
+		if user.MailAddress != "" {
+			fmt.Fprintf(&sb, "  - Mail: %s\n", user.MailAddress)
+		}
+	}
+
+	return sb.String()
+}
+
+// ResolveUserIDs resolves a comma-separated list of users to numeric user IDs.
+func ResolveUserIDs(users []User, list string) ([]string, error) {
+	resolved, err := ResolveUsers(users, list)
+	if err != nil {
+		return nil, err
+	}
+	return UserIDs(resolved), nil
+}
+
+// ResolveUsers resolves a comma-separated list of users among users.
+func ResolveUsers(users []User, list string) ([]User, error) {
+	var resolved []User
+	for query := range strings.SplitSeq(list, ",") {
+		query = strings.TrimSpace(query)
+		if query == "" {
+			continue
+		}
+		user, err := FindUser(users, query)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, *user)
+	}
+	return resolved, nil
+}
+
+// ResolveIssueUserIDs resolves a comma-separated list of users to the IDs
+// of members of the issue's project.
+func (c *Client) ResolveIssueUserIDs(issueKeyOrID string, list string) ([]string, error) {
+	_, users, err := c.issueProjectUsers(issueKeyOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ResolveUserIDs(users, list)
+}
+
+// issueProjectUsers returns the issue and the members of its project.
+func (c *Client) issueProjectUsers(issueKeyOrID string) (*Issue, []User, error) {
+	data, err := c.GetIssue(issueKeyOrID)
+	if err != nil {
+		return nil, nil, err
+	}
+	issue, err := ParseIssue(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err = c.GetProjectUsers(strconv.Itoa(issue.ProjectId))
+	if err != nil {
+		return nil, nil, err
+	}
+	users, err := ParseUsers(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return issue, users, nil
+}
+
+// Notification scopes for comments and issue updates. The API only takes
+// an explicit list of users to notify; watchers and the assignee are also
+// notified by Backlog itself.
+const (
+	NotifyNone     = "none"
+	NotifyUsers    = "users"
+	NotifyTeam     = "team"
+	NotifyWatchers = "watchers"
+)
+
+// ResolveNotifiedUsers resolves who to notify about a change to an issue.
+// With NotifyUsers, list holds users; with NotifyTeam, it holds team names
+// or IDs, and the teams' members in the issue's project are notified. With
+// NotifyWatchers, the project members watching the issue are notified. An
+// empty scope means NotifyUsers.
+func (c *Client) ResolveNotifiedUsers(issueKeyOrID string, scope string, list string) ([]User, error) {
+	switch scope {
+	case NotifyNone, NotifyWatchers:
+		if list != "" {
+			return nil, fmt.Errorf("cannot notify users with scope %q", scope)
+		}
+		if scope == NotifyNone {
+			return nil, nil
+		}
+	case "", NotifyUsers, NotifyTeam:
+		if list == "" {
+			if scope == NotifyTeam {
+				return nil, fmt.Errorf("scope %q requires a team to notify", NotifyTeam)
+			}
+			return nil, nil
+		}
+	default:
+		return nil, fmt.Errorf("invalid notification scope %q (expected none, users, team, or watchers)", scope)
+	}
+
+	issue, members, err := c.issueProjectUsers(issueKeyOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch scope {
+	case NotifyWatchers:
+		return c.issueWatchers(issue.ID, members)
+	case NotifyTeam:
+		return c.teamMembers(list, members)
+	}
+	return ResolveUsers(members, list)
+}
+
+// issueWatchers returns the members who watch the issue. The API has no
+// list of an issue's watchers, so each member's watch list is checked.
+func (c *Client) issueWatchers(issueID int, members []User) ([]User, error) {
+	query := url.Values{}
+	query.Set("issueId[]", strconv.Itoa(issueID))
+	query.Set("count", "1")
+
+	var users []User
+	for _, member := range members {
+		data, err := c.GetWatchings(member.ID, query)
+		if err != nil {
+			return nil, err
+		}
+		watchings, err := ParseWatchings(data)
+		if err != nil {
+			return nil, err
+		}
+		if len(watchings) > 0 {
+			users = append(users, member)
+		}
+	}
+	return users, nil
+}
+
+// teamMembers returns the members of the teams in list who belong to the
+// project with the given members.
+func (c *Client) teamMembers(list string, members []User) ([]User, error) {
+	data, err := c.GetTeams()
+	if err != nil {
+		return nil, err
+	}
+	teams, err := ParseTeams(data)
+	if err != nil {
+		return nil, err
+	}
+
+	inProject := map[int]bool{}
+	for _, member := range members {
+		inProject[member.ID] = true
+	}
+
+	var users []User
+	seen := map[int]bool{}
+	for query := range strings.SplitSeq(list, ",") {
+		query = strings.TrimSpace(query)
+		if query == "" {
+			continue
+		}
+		team, err := FindTeam(teams, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range team.Members {
+			if inProject[member.ID] && !seen[member.ID] {
+				seen[member.ID] = true
+				users = append(users, member)
+			}
+		}
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("no members of %s belong to the issue's project", list)
+	}
+	return users, nil
+}
+
+// DescribeNotified describes who will be notified in the given scope, for
+// confirmation prompts and progress output.
+func DescribeNotified(scope string, list string, notified []User) string {
+	names := "nobody"
+	if len(notified) > 0 {
+		names = UserNames(notified)
+	}
+	switch scope {
+	case NotifyNone:
+		return "none (watchers and the assignee are still notified by Backlog)"
+	case NotifyTeam:
+		return fmt.Sprintf("team %s (%s)", list, names)
+	case NotifyWatchers:
+		return fmt.Sprintf("watchers (%s)", names)
+	}
+	if len(notified) == 0 {
+		return "users (nobody explicitly; watchers and the assignee are still notified by Backlog)"
+	}
+	return fmt.Sprintf("users (%s)", names)
+}
+
+// UserIDs returns the numeric IDs of users as strings.
+func UserIDs(users []User) []string {
+	ids := make([]string, len(users))
+	for i, user := range users {
+		ids[i] = strconv.Itoa(user.ID)
+	}
+	return ids
+}
+
+// UserNames returns the names of users joined with commas.
+func UserNames(users []User) string {
+	names := make([]string, len(users))
+	for i, user := range users {
+		names[i] = user.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// GetTeams retrieves the list of teams in the space.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-list-of-teams/
+func (c *Client) GetTeams() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/teams?count=100")
+}
+
+// GetTeam retrieves a team by numeric ID.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-team/
+func (c *Client) GetTeam(teamID int) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/teams/"+strconv.Itoa(teamID))
+}
+
+// GetProjectTeams retrieves the teams attached to a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-project-team-list/
+func (c *Client) GetProjectTeams(projectIDOrKey string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/projects/"+projectIDOrKey+"/teams")
+}
+
+// Team represents a team in a Backlog space.
+type Team struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Members []User `json:"members"`
+	Created string `json:"created"`
+	Updated string `json:"updated"`
+}
+
+// ParseTeam parses the JSON response into a Team struct.
+func ParseTeam(data []byte) (*Team, error) {
+	var team Team
+	if err := json.Unmarshal(data, &team); err != nil {
+		return nil, fmt.Errorf("failed to parse team: %w", err)
+	}
+	return &team, nil
+}
+
+// ParseTeams parses the JSON response into a slice of Team structs.
+func ParseTeams(data []byte) ([]Team, error) {
+	var teams []Team
+	if err := json.Unmarshal(data, &teams); err != nil {
+		return nil, fmt.Errorf("failed to parse teams: %w", err)
+	}
+	return teams, nil
+}
+
+// FindTeam finds a team by numeric ID or name (case-insensitive).
+func FindTeam(teams []Team, query string) (*Team, error) {
+	for i, team := range teams {
+		if strconv.Itoa(team.ID) == query || strings.EqualFold(team.Name, query) {
+			return &teams[i], nil
+		}
+	}
+	return nil, fmt.Errorf("team not found: %s", query)
+}
+
+// FormatTeamsMarkdown formats a list of teams as Markdown with their
+// member counts.
+func FormatTeamsMarkdown(teams []Team) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Team\n")
+	if len(teams) == 0 {
+		sb.WriteString("\nNo teams.\n")
+		return sb.String()
+	}
+	for _, team := range teams {
+		members := "members"
+		if len(team.Members) == 1 {
+			members = "member"
+		}
+		fmt.Fprintf(&sb, "- %s (id: %d, %s %s)\n", team.Name, team.ID, locale.Number(int64(len(team.Members))), members)
+	}
+
+	return sb.String()
+}
+
+// FormatTeamMarkdown formats a team and its members as Markdown.
+func FormatTeamMarkdown(team *Team) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "## %s (id: %d)\n", team.Name, team.ID)
+	if team.Updated != "" {
+		fmt.Fprintf(&sb, "Updated %s\n", locale.DateTimeString(team.Updated))
+	}
+
+	sb.WriteString("\n### Members\n")
+	if len(team.Members) == 0 {
+		sb.WriteString("\nNo members.\n")
+		return sb.String()
+	}
+	for _, member := range team.Members {
+		fmt.Fprintf(&sb, "- %s (%s)", member.Name, RoleName(member.RoleType))
+		if member.MailAddress != "" {
+			fmt.Fprintf(&sb, " <%s>", member.MailAddress)
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// GetWebhooks retrieves the webhook list for a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-list-of-webhooks/
+func (c *Client) GetWebhooks(projectIDOrKey string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/projects/"+projectIDOrKey+"/webhooks")
+}
+
+// AddWebhook adds a webhook to a project. With no activity type IDs, the
+// webhook is called for all events.
+// ref: https://developer.nulab.com/docs/backlog/api/2/add-webhook/
+func (c *Client) AddWebhook(projectIDOrKey string, name string, description string, hookURL string, activityTypeIDs []int) ([]byte, error) {
+	data := url.Values{}
+	data.Set("name", name)
+	data.Set("hookUrl", hookURL)
+	if description != "" {
+		data.Set("description", description)
+	}
+	if len(activityTypeIDs) == 0 {
+		data.Set("allEvent", "true")
+	} else {
+		data.Set("allEvent", "false")
+		for _, id := range activityTypeIDs {
+			data.Add("activityTypeIds[]", strconv.Itoa(id))
+		}
+	}
+	return c.doPostRequest("/api/v2/projects/"+projectIDOrKey+"/webhooks", data)
+}
+
+// DeleteWebhook deletes a webhook from a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/delete-webhook/
+func (c *Client) DeleteWebhook(projectIDOrKey string, webhookID int) ([]byte, error) {
+	return c.doRequest("DELETE", "/api/v2/projects/"+projectIDOrKey+"/webhooks/"+strconv.Itoa(webhookID))
+}
+
+// Webhook represents a project webhook.
+type Webhook struct {
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	HookURL         string `json:"hookUrl"`
+	AllEvent        bool   `json:"allEvent"`
+	ActivityTypeIDs []int  `json:"activityTypeIds"`
+}
+
+// ParseWebhooks parses the JSON response into a slice of Webhook structs.
+func ParseWebhooks(data []byte) ([]Webhook, error) {
+	var webhooks []Webhook
+	if err := json.Unmarshal(data, &webhooks); err != nil {
+		return nil, fmt.Errorf("failed to parse webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+// ParseWebhook parses the JSON response into a Webhook struct.
+func ParseWebhook(data []byte) (*Webhook, error) {
+	var webhook Webhook
+	if err := json.Unmarshal(data, &webhook); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook: %w", err)
+	}
+	return &webhook, nil
+}
+
+// FindWebhook finds a webhook by numeric ID or name (case-insensitive).
+func FindWebhook(webhooks []Webhook, query string) (*Webhook, error) {
+	for i, webhook := range webhooks {
+		if strconv.Itoa(webhook.ID) == query || strings.EqualFold(webhook.Name, query) {
+			return &webhooks[i], nil
+		}
+	}
+	return nil, fmt.Errorf("webhook not found: %s", query)
+}
+
+// WebhookEvents describes the events a webhook is called for.
+func WebhookEvents(webhook *Webhook) string {
+	if webhook.AllEvent {
+		return "all events"
+	}
+	labels := make([]string, 0, len(webhook.ActivityTypeIDs))
+	for _, id := range webhook.ActivityTypeIDs {
+		labels = append(labels, ActivityTypeLabel(id))
+	}
+	return strings.Join(labels, ", ")
+}
+
+// FormatWebhooksMarkdown formats a list of webhooks as Markdown.
+func FormatWebhooksMarkdown(webhooks []Webhook) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Webhook\n")
+	if len(webhooks) == 0 {
+		sb.WriteString("\nNo webhooks.\n")
+		return sb.String()
+	}
+	for _, webhook := range webhooks {
+		fmt.Fprintf(&sb, "- %s (id: %d)\n", webhook.Name, webhook.ID)
+		fmt.Fprintf(&sb, "  - URL: %s\n", webhook.HookURL)
+		fmt.Fprintf(&sb, "  - Events: %s\n", WebhookEvents(&webhook))
+		if webhook.Description != "" {
+			fmt.Fprintf(&sb, "  - Description: %s\n", webhook.Description)
+		}
+	}
+
+	return sb.String()
+}
+
+// maxActivityCount is the largest page the activities APIs return.
+const maxActivityCount = 100
+
+// FetchActivities fetches up to limit activities, newest first, paging
+// back with maxId since each request returns at most 100. get performs one
+// request of an activities API with the given query.
+func FetchActivities(limit int, query url.Values, get func(url.Values) ([]byte, error)) ([]byte, error) {
+	all := []json.RawMessage{}
+	seen := map[int]bool{}
+	for len(all) < limit {
+		count := min(limit-len(all), maxActivityCount)
+		query.Set("count", strconv.Itoa(count))
+		data, err := get(query)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []json.RawMessage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse activities: %w", err)
+		}
+
+		// Whether maxId is inclusive or not, skip anything already fetched
+		fetched := 0
+		lastID := 0
+		for _, item := range page {
+			var a struct {
+				ID int `json:"id"`
+			}
+			if err := json.Unmarshal(item, &a); err != nil {
+				return nil, fmt.Errorf("failed to parse activity: %w", err)
+			}
+			lastID = a.ID
+			if seen[a.ID] || len(all) >= limit {
+				continue
+			}
+			seen[a.ID] = true
+			fetched++
+			all = append(all, item)
+		}
+		if fetched == 0 || len(page) < count {
+			break
+		}
+		query.Set("maxId", strconv.Itoa(lastID))
+	}
+	return json.Marshal(all)
+}
+
+// GetProjectActivities retrieves the recent activities of a project. The
+// query may set activityTypeId[], minId, maxId, count, and order.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-project-recent-updates/
+func (c *Client) GetProjectActivities(projectIDOrKey string, query url.Values) ([]byte, error) {
+	path := "/api/v2/projects/" + projectIDOrKey + "/activities"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// GetUserActivities retrieves the recent activities of a user. The query
+// may set activityTypeId[], minId, maxId, count, and order.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-user-recent-updates/
+func (c *Client) GetUserActivities(userID int, query url.Values) ([]byte, error) {
+	path := "/api/v2/users/" + strconv.Itoa(userID) + "/activities"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// GetProjectDiskUsage retrieves the disk usage of a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-project-disk-usage/
+func (c *Client) GetProjectDiskUsage(projectIDOrKey string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/projects/"+projectIDOrKey+"/diskUsage")
+}
+
+// ProjectDiskUsage is the disk usage of a project in bytes, by feature.
+type ProjectDiskUsage struct {
+	ProjectID  int   `json:"projectId"`
+	Issue      int64 `json:"issue"`
+	Wiki       int64 `json:"wiki"`
+	File       int64 `json:"file"`
+	Subversion int64 `json:"subversion"`
+	Git        int64 `json:"git"`
+	GitLFS     int64 `json:"gitLFS"`
+}
+
+// ParseProjectDiskUsage parses the JSON response into a ProjectDiskUsage struct.
+func ParseProjectDiskUsage(data []byte) (*ProjectDiskUsage, error) {
+	var usage ProjectDiskUsage
+	if err := json.Unmarshal(data, &usage); err != nil {
+		return nil, fmt.Errorf("failed to parse disk usage: %w", err)
+	}
+	return &usage, nil
+}
+
+// FormatProjectDiskUsageMarkdown formats a project's disk usage as a
+// Markdown table with human-readable sizes.
+func FormatProjectDiskUsageMarkdown(projectIDOrKey string, usage *ProjectDiskUsage) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "## Disk Usage of %s\n", projectIDOrKey)
+	sb.WriteString("| Feature | Size |\n")
+	sb.WriteString("|---------|-----:|\n")
+	rows := []struct {
+		name string
+		size int64
+	}{
+		{"Issue attachments", usage.Issue},
+		{"Wiki attachments", usage.Wiki},
+		{"File sharing", usage.File},
+		{"Subversion", usage.Subversion},
+		{"Git", usage.Git},
+		{"Git LFS", usage.GitLFS},
+	}
+	var total int64
+	for _, row := range rows {
+		fmt.Fprintf(&sb, "| %s | %s |\n", row.name, locale.Size(row.size))
+		total += row.size
+	}
+	fmt.Fprintf(&sb, "| **Total** | **%s** |\n", locale.Size(total))
+
+	return sb.String()
+}
+
+// GetSharedFiles retrieves the shared files and directories in a directory
+// of a project's file storage. The query may set order, offset, and count.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-list-of-shared-files/
+func (c *Client) GetSharedFiles(projectIDOrKey string, dir string, query url.Values) ([]byte, error) {
+	path := "/api/v2/projects/" + projectIDOrKey + "/files/metadata/" + escapeFilePath(dir)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// DownloadSharedFile downloads a shared file by ID.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-file/
+func (c *Client) DownloadSharedFile(projectIDOrKey string, sharedFileID int) ([]byte, string, error) {
+	return c.doDownload("/api/v2/projects/" + projectIDOrKey + "/files/" + strconv.Itoa(sharedFileID))
+}
+
+// escapeFilePath escapes each segment of a slash-separated file path.
+func escapeFilePath(p string) string {
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// SharedFile represents a file or directory in a project's file storage.
+// Type is "file" or "directory"; Dir is the parent directory, ending in "/".
+type SharedFile struct {
+	ID          int    `json:"id"`
+	Type        string `json:"type"`
+	Dir         string `json:"dir"`
+	Name        string `json:"name"`
+	Size        int64  `json:"size"`
+	CreatedUser *User  `json:"createdUser"`
+	Created     string `json:"created"`
+	Updated     string `json:"updated"`
+}
+
+// ParseSharedFiles parses the JSON response into a slice of SharedFile structs.
+func ParseSharedFiles(data []byte) ([]SharedFile, error) {
+	var files []SharedFile
+	if err := json.Unmarshal(data, &files); err != nil {
+		return nil, fmt.Errorf("failed to parse shared files: %w", err)
+	}
+	return files, nil
+}
+
+// FormatSharedFilesMarkdown formats the contents of a shared file directory
+// as Markdown, directories first.
+func FormatSharedFilesMarkdown(dir string, files []SharedFile) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "## %s\n", "/"+strings.Trim(dir, "/"))
+	if len(files) == 0 {
+		sb.WriteString("\n(empty)\n")
+		return sb.String()
+	}
+	for _, file := range files {
+		if file.Type == "directory" {
+			fmt.Fprintf(&sb, "- %s/\n", file.Name)
+		}
+	}
+	for _, file := range files {
+		if file.Type != "directory" {
+			fmt.Fprintf(&sb, "- %s (id: %d, %s, updated %s)\n", file.Name, file.ID, locale.Size(file.Size), locale.DateString(file.Updated))
+		}
+	}
+
+	return sb.String()
+}
+
+// GetWikis retrieves the wiki page list of a project. The query may set
+// keyword.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-list/
+func (c *Client) GetWikis(projectIDOrKey string, query url.Values) ([]byte, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("projectIdOrKey", projectIDOrKey)
+	return c.doRequest("GET", "/api/v2/wikis?"+query.Encode())
+}
+
+// Wiki represents a Backlog wiki page. Content is only set when a single
+// page is fetched.
+type Wiki struct {
+	ID          int       `json:"id"`
+	ProjectID   int       `json:"projectId"`
+	Name        string    `json:"name"`
+	Content     string    `json:"content"`
+	Tags        []WikiTag `json:"tags"`
+	CreatedUser *User     `json:"createdUser"`
+	Created     string    `json:"created"`
+	UpdatedUser *User     `json:"updatedUser"`
+	Updated     string    `json:"updated"`
+}
+
+// WikiTag represents a wiki tag.
+type WikiTag struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ParseWikis parses the JSON response into a slice of Wiki structs.
+func ParseWikis(data []byte) ([]Wiki, error) {
+	var wikis []Wiki
+	if err := json.Unmarshal(data, &wikis); err != nil {
+		return nil, fmt.Errorf("failed to parse wiki pages: %w", err)
+	}
+	return wikis, nil
+}
+
+// wikiTagNames returns the names of a page's tags.
+func wikiTagNames(tags []WikiTag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+// FormatWikisMarkdown formats a list of wiki pages as Markdown.
+func FormatWikisMarkdown(wikis []Wiki) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Wiki\n")
+	if len(wikis) == 0 {
+		sb.WriteString("\nNo wiki pages.\n")
+		return sb.String()
+	}
+	for _, wiki := range wikis {
+		fmt.Fprintf(&sb, "- %s (id: %d)", wiki.Name, wiki.ID)
+		if len(wiki.Tags) > 0 {
+			fmt.Fprintf(&sb, " [%s]", strings.Join(wikiTagNames(wiki.Tags), ", "))
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// GetWiki retrieves a single wiki page, including its content.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page/
+func (c *Client) GetWiki(wikiID string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/wikis/"+wikiID)
+}
+
+// ParseWiki parses the JSON response into a Wiki struct.
+func ParseWiki(data []byte) (*Wiki, error) {
+	var wiki Wiki
+	if err := json.Unmarshal(data, &wiki); err != nil {
+		return nil, fmt.Errorf("failed to parse wiki page: %w", err)
+	}
+	return &wiki, nil
+}
+
+// FindWiki finds a wiki page by numeric ID or name (case-insensitive).
+func FindWiki(wikis []Wiki, query string) (*Wiki, error) {
+	for i, wiki := range wikis {
+		if strconv.Itoa(wiki.ID) == query || strings.EqualFold(wiki.Name, query) {
+			return &wikis[i], nil
+		}
+	}
+	return nil, fmt.Errorf("wiki page not found: %s", query)
+}
+
+// FormatWikiMarkdown formats a wiki page as Markdown.
+func FormatWikiMarkdown(wiki *Wiki) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s\n\n", wiki.Name)
+
+	sb.WriteString("## Metadata\n")
+	fmt.Fprintf(&sb, "- ID: %d\n", wiki.ID)
+	if len(wiki.Tags) > 0 {
+		fmt.Fprintf(&sb, "- Tags: %s\n", strings.Join(wikiTagNames(wiki.Tags), ", "))
+	}
+	if wiki.CreatedUser != nil {
+		fmt.Fprintf(&sb, "- Created: %s by %s\n", formatDate(wiki.Created), wiki.CreatedUser.Name)
+	}
+	if wiki.UpdatedUser != nil {
+		fmt.Fprintf(&sb, "- Updated: %s by %s\n", formatDate(wiki.Updated), wiki.UpdatedUser.Name)
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## Content\n\n")
+	if wiki.Content != "" {
+		sb.WriteString(wiki.Content)
+	} else {
+		sb.WriteString("(no content)")
+	}
+	sb.WriteString("\n")
+
+	return sb.String()
+}
+
+// AddWiki creates a wiki page. The Add Wiki Page API only accepts a numeric
+// projectId.
+// ref: https://developer.nulab.com/docs/backlog/api/2/add-wiki-page/
+func (c *Client) AddWiki(projectID int, name string, content string, mailNotify bool) ([]byte, error) {
+	data := url.Values{}
+	data.Set("projectId", strconv.Itoa(projectID))
+	data.Set("name", name)
+	data.Set("content", content)
+	if mailNotify {
+		data.Set("mailNotify", "true")
+	}
+	return c.doPostRequest("/api/v2/wikis", data)
+}
+
+// UpdateWiki updates a wiki page. The data may set name, content and
+// mailNotify.
+// ref: https://developer.nulab.com/docs/backlog/api/2/update-wiki-page/
+func (c *Client) UpdateWiki(wikiID string, data url.Values) ([]byte, error) {
+	return c.doPatchRequest("/api/v2/wikis/"+wikiID, data)
+}
+
+// DeleteWiki deletes a wiki page, optionally notifying project members by
+// mail.
+// ref: https://developer.nulab.com/docs/backlog/api/2/delete-wiki-page/
+func (c *Client) DeleteWiki(wikiID string, mailNotify bool) ([]byte, error) {
+	data := url.Values{}
+	if mailNotify {
+		data.Set("mailNotify", "true")
+	}
+	return c.doDeleteRequest("/api/v2/wikis/"+wikiID, data)
+}
+
+// GetWikiHistory retrieves the revisions of a wiki page. The query may set
+// minId, maxId, count and order; minId and maxId refer to versions.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-history/
+func (c *Client) GetWikiHistory(wikiID string, query url.Values) ([]byte, error) {
+	path := "/api/v2/wikis/" + wikiID + "/history"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// WikiRevision represents a single version of a wiki page.
+type WikiRevision struct {
+	PageID      int    `json:"pageId"`
+	Version     int    `json:"version"`
+	Name        string `json:"name"`
+	Content     string `json:"content"`
+	CreatedUser *User  `json:"createdUser"`
+	Created     string `json:"created"`
+}
+
+// ParseWikiHistory parses the JSON response into a slice of WikiRevision
+// structs.
+func ParseWikiHistory(data []byte) ([]WikiRevision, error) {
+	var revisions []WikiRevision
+	if err := json.Unmarshal(data, &revisions); err != nil {
+		return nil, fmt.Errorf("failed to parse wiki history: %w", err)
+	}
+	return revisions, nil
+}
+
+// doUpload posts a file as multipart/form-data with authentication and
+// error handling.
+func (c *Client) doUpload(path string, filename string, content []byte) ([]byte, error) {
+	apiURL := fmt.Sprintf("https://%s%s", c.cfg.Space, path)
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", apiURL, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	c.authorize(req)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle authentication errors
+	if resp.StatusCode == http.StatusUnauthorized {
+		wwwAuth := resp.Header.Get("WWW-Authenticate")
+		if strings.Contains(wwwAuth, "The access token expired") {
+			// Token expired - try to refresh
+			if err := auth.RefreshToken(); err != nil {
+				return nil, fmt.Errorf("access token expired and refresh failed: %w. Please run 'bgl auth login'", err)
+			}
+			// Reload config and retry
+			cfg, err := config.Load()
+			if err != nil {
+				return nil, fmt.Errorf("failed to reload config: %w", err)
+			}
+			c.cfg = cfg
+			return c.doUpload(path, filename, content)
+		}
+		if strings.Contains(wwwAuth, "The access token is invalid") {
+			return nil, fmt.Errorf("access token is invalid. Please run 'bgl auth login'")
+		}
+		return nil, fmt.Errorf("authentication failed (status %d). Please run 'bgl auth login'", resp.StatusCode)
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}
+
+// UploadAttachment uploads a file to the space. The returned attachment ID
+// is then linked to an issue or wiki page with attachmentId[].
+// ref: https://developer.nulab.com/docs/backlog/api/2/post-attachment-file/
+func (c *Client) UploadAttachment(filename string, content []byte) ([]byte, error) {
+	return c.doUpload("/api/v2/space/attachment", filename, content)
+}
+
+// UploadFiles uploads local files to the space, returning the uploaded
+// attachments in the same order.
+func (c *Client) UploadFiles(paths []string) ([]Attachment, error) {
+	attachments := make([]Attachment, 0, len(paths))
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+		data, err := c.UploadAttachment(filepath.Base(path), content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload %s: %w", path, err)
+		}
+		attachment, err := ParseAttachment(data)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, *attachment)
+	}
+	return attachments, nil
+}
+
+// AttachmentIDs returns the IDs of attachments as strings, for use as
+// attachmentId[] form values.
+func AttachmentIDs(attachments []Attachment) []string {
+	ids := make([]string, len(attachments))
+	for i, attachment := range attachments {
+		ids[i] = strconv.Itoa(attachment.ID)
+	}
+	return ids
+}
+
+// ParseAttachment parses the JSON response into an Attachment struct.
+func ParseAttachment(data []byte) (*Attachment, error) {
+	var attachment Attachment
+	if err := json.Unmarshal(data, &attachment); err != nil {
+		return nil, fmt.Errorf("failed to parse attachment: %w", err)
+	}
+	return &attachment, nil
+}
+
+// GetWikiAttachments retrieves the attachment list of a wiki page.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-list-of-wiki-attachments/
+func (c *Client) GetWikiAttachments(wikiID string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/wikis/"+wikiID+"/attachments")
+}
+
+// AddWikiAttachments links uploaded attachments to a wiki page.
+// ref: https://developer.nulab.com/docs/backlog/api/2/attach-file-to-wiki/
+func (c *Client) AddWikiAttachments(wikiID string, attachmentIDs []string) ([]byte, error) {
+	data := url.Values{}
+	data["attachmentId[]"] = attachmentIDs
+	return c.doPostRequest("/api/v2/wikis/"+wikiID+"/attachments", data)
+}
+
+// DownloadWikiAttachment downloads a wiki page's attachment file.
+// It returns the file content and the filename from the Content-Disposition
+// header (empty string if the header has no filename).
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-attachment/
+func (c *Client) DownloadWikiAttachment(wikiID string, attachmentID string) ([]byte, string, error) {
+	return c.doDownload("/api/v2/wikis/" + wikiID + "/attachments/" + attachmentID)
+}
+
+// GetWikiTags retrieves the wiki tags used in a project.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-wiki-page-tag-list/
+func (c *Client) GetWikiTags(projectIDOrKey string) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/wikis/tags?projectIdOrKey="+url.QueryEscape(projectIDOrKey))
+}
+
+// ParseWikiTags parses the JSON response into a slice of WikiTag structs.
+func ParseWikiTags(data []byte) ([]WikiTag, error) {
+	var tags []WikiTag
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse wiki tags: %w", err)
+	}
+	return tags, nil
+}
+
+// FormatWikiTagsMarkdown formats a list of wiki tags as Markdown.
+func FormatWikiTagsMarkdown(tags []WikiTag) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Wiki Tag\n")
+	if len(tags) == 0 {
+		sb.WriteString("\nNo tags.\n")
+		return sb.String()
+	}
+	for _, tag := range tags {
+		fmt.Fprintf(&sb, "- %s (id: %d)\n", tag.Name, tag.ID)
+	}
+
+	return sb.String()
+}
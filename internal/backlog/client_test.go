@@ -0,0 +1,43 @@
+package backlog
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/dannygim/bgl/internal/config"
+)
+
+func TestSendKeepsURLErrorWithoutAPIKey(t *testing.T) {
+	// Reserve a port, then close it so the connection is refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	client := &Client{
+		cfg:        &config.Config{Space: addr, APIKey: "secret-api-key"},
+		httpClient: &http.Client{},
+	}
+
+	_, err = client.doRequest("GET", "/api/v2/users/myself")
+	if err == nil {
+		t.Fatal("expected an error from a closed port")
+	}
+
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		t.Errorf("error %q is not a *url.Error", err)
+	}
+	if strings.Contains(err.Error(), "secret-api-key") {
+		t.Errorf("error %q contains the API key", err)
+	}
+	if !strings.Contains(err.Error(), "/api/v2/users/myself") {
+		t.Errorf("error %q does not mention the path", err)
+	}
+}
@@ -0,0 +1,43 @@
+package backlog
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// debugTransport logs each request to stderr when BGL_DEBUG is set.
+type debugTransport struct {
+	base http.RoundTripper
+}
+
+// newTransport returns the HTTP transport for API requests. Requests are
+// written to the request log only when requestLog is set or BGL_DEBUG is.
+func newTransport(requestLog bool) http.RoundTripper {
+	debug := os.Getenv("BGL_DEBUG") != ""
+	transport := http.DefaultTransport
+	if requestLog || debug {
+		transport = &logTransport{base: transport}
+	}
+	if !debug {
+		return transport
+	}
+	return &debugTransport{base: transport}
+}
+
+// RoundTrip implements http.RoundTripper. The query string is omitted
+// from the log since it may carry credentials.
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	fmt.Fprintf(os.Stderr, "[debug] %s %s://%s%s\n", req.Method, req.URL.Scheme, req.URL.Host, req.URL.Path)
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[debug]   error: %v (%s)\n", err, time.Since(start).Round(time.Millisecond))
+		return nil, err
+	}
+
+	fmt.Fprintf(os.Stderr, "[debug]   %s (%s)\n", resp.Status, time.Since(start).Round(time.Millisecond))
+	return resp, nil
+}
@@ -0,0 +1,153 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/locale"
+)
+
+// GetNotifications retrieves the notifications of the authenticated user,
+// newest first. The query may set minId, maxId, count, and order.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-notification/
+func (c *Client) GetNotifications(query url.Values) ([]byte, error) {
+	path := "/api/v2/notifications"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// GetNotificationCount retrieves the number of notifications. The query
+// may set alreadyRead and resourceAlreadyRead.
+// ref: https://developer.nulab.com/docs/backlog/api/2/count-notification/
+func (c *Client) GetNotificationCount(query url.Values) ([]byte, error) {
+	path := "/api/v2/notifications/count"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// Notification represents an entry of the notification inbox.
+type Notification struct {
+	ID                 int                      `json:"id"`
+	AlreadyRead        bool                     `json:"alreadyRead"`
+	Reason             int                      `json:"reason"`
+	Project            *ActivityProject         `json:"project"`
+	Issue              *Issue                   `json:"issue"`
+	Comment            *ActivityComment         `json:"comment"`
+	PullRequest        *NotificationPullRequest `json:"pullRequest"`
+	PullRequestComment *ActivityComment         `json:"pullRequestComment"`
+	Sender             *CommentUser             `json:"sender"`
+	Created            string                   `json:"created"`
+}
+
+// NotificationPullRequest identifies the pull request of a notification.
+type NotificationPullRequest struct {
+	ID      int    `json:"id"`
+	Number  int    `json:"number"`
+	Summary string `json:"summary"`
+}
+
+// notificationReasons maps notification reason IDs to labels.
+var notificationReasons = map[int]string{
+	1:  "Assigned to you",
+	2:  "Commented",
+	3:  "Issue created",
+	4:  "Issue updated",
+	5:  "File attached",
+	6:  "Added to project",
+	9:  "Other",
+	10: "Pull request assigned to you",
+	11: "Pull request commented",
+	12: "Pull request added",
+	13: "Pull request updated",
+}
+
+// NotificationReasonLabel returns the label of a notification reason ID.
+func NotificationReasonLabel(reason int) string {
+	if label, ok := notificationReasons[reason]; ok {
+		return label
+	}
+	return fmt.Sprintf("Reason %d", reason)
+}
+
+// ParseNotifications parses the JSON response into a slice of Notification
+// structs.
+func ParseNotifications(data []byte) ([]Notification, error) {
+	var notifications []Notification
+	if err := json.Unmarshal(data, &notifications); err != nil {
+		return nil, fmt.Errorf("failed to parse notifications: %w", err)
+	}
+	return notifications, nil
+}
+
+// notificationSnippetLength is the longest comment snippet shown per
+// notification, in runes.
+const notificationSnippetLength = 80
+
+// notificationSnippet flattens a comment to a single line, shortened to
+// notificationSnippetLength.
+func notificationSnippet(content string) string {
+	snippet := []rune(strings.Join(strings.Fields(content), " "))
+	if len(snippet) > notificationSnippetLength {
+		return string(snippet[:notificationSnippetLength]) + "…"
+	}
+	return string(snippet)
+}
+
+// FormatNotificationMarkdownLine formats a notification as a Markdown list
+// item: when, who, why, what, and a snippet of the comment if any. Unread
+// notifications are marked with a dot.
+func FormatNotificationMarkdownLine(notification *Notification) string {
+	var sb strings.Builder
+
+	mark := " "
+	if !notification.AlreadyRead {
+		mark = "●"
+	}
+	sender := "(unknown)"
+	if notification.Sender != nil {
+		sender = notification.Sender.Name
+	}
+	fmt.Fprintf(&sb, "- %s %s **%s** — %s", mark, locale.DateTimeString(notification.Created), sender, NotificationReasonLabel(notification.Reason))
+
+	switch {
+	case notification.PullRequest != nil:
+		fmt.Fprintf(&sb, ": #%d %s", notification.PullRequest.Number, notification.PullRequest.Summary)
+	case notification.Issue != nil:
+		fmt.Fprintf(&sb, ": %s %s", notification.Issue.IssueKey, notification.Issue.Summary)
+	case notification.Project != nil:
+		fmt.Fprintf(&sb, ": %s", notification.Project.Name)
+	}
+	sb.WriteString("\n")
+
+	comment := notification.Comment
+	if notification.PullRequestComment != nil {
+		comment = notification.PullRequestComment
+	}
+	if comment != nil && comment.Content != "" {
+		fmt.Fprintf(&sb, "  > %s\n", notificationSnippet(comment.Content))
+	}
+
+	return sb.String()
+}
+
+// FormatNotificationsMarkdown formats notifications as Markdown.
+func FormatNotificationsMarkdown(notifications []Notification) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Notification\n")
+	if len(notifications) == 0 {
+		sb.WriteString("\nNo notifications.\n")
+		return sb.String()
+	}
+	for i := range notifications {
+		sb.WriteString(FormatNotificationMarkdownLine(&notifications[i]))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,76 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dannygim/bgl/internal/locale"
+)
+
+// GetRateLimit retrieves the API rate limit status of the authenticated
+// user.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-rate-limit/
+func (c *Client) GetRateLimit() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/rateLimit")
+}
+
+// RateLimit is the quota of one kind of API request. Reset is the Unix
+// time at which Remaining returns to Limit.
+type RateLimit struct {
+	Limit     int   `json:"limit"`
+	Remaining int   `json:"remaining"`
+	Reset     int64 `json:"reset"`
+}
+
+// RateLimits holds the quotas for each kind of API request.
+type RateLimits struct {
+	Read   *RateLimit `json:"read"`
+	Update *RateLimit `json:"update"`
+	Search *RateLimit `json:"search"`
+	Icon   *RateLimit `json:"icon"`
+}
+
+// ParseRateLimits parses the JSON response into a RateLimits struct.
+func ParseRateLimits(data []byte) (*RateLimits, error) {
+	var response struct {
+		RateLimit RateLimits `json:"rateLimit"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse rate limit: %w", err)
+	}
+	return &response.RateLimit, nil
+}
+
+// FormatRateLimitsMarkdown formats the rate limit quotas as a Markdown
+// table with their reset times.
+func FormatRateLimitsMarkdown(limits *RateLimits) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Rate Limit\n")
+	sb.WriteString("| Type | Remaining | Limit | Resets |\n")
+	sb.WriteString("|------|----------:|------:|--------|\n")
+	rows := []struct {
+		name  string
+		limit *RateLimit
+	}{
+		{"Read", limits.Read},
+		{"Update", limits.Update},
+		{"Search", limits.Search},
+		{"Icon", limits.Icon},
+	}
+	for _, row := range rows {
+		if row.limit == nil {
+			continue
+		}
+		reset := "-"
+		if row.limit.Reset > 0 {
+			reset = locale.DateTime(time.Unix(row.limit.Reset, 0))
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s |\n", row.name,
+			locale.Number(int64(row.limit.Remaining)), locale.Number(int64(row.limit.Limit)), reset)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,103 @@
+package backlog
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dannygim/bgl/internal/config"
+)
+
+// requestLogFileName is the request log in the state directory. When it
+// grows past maxRequestLogSize it is moved to requestLogFileName + ".1".
+const (
+	requestLogFileName = "requests.log"
+	maxRequestLogSize  = 1 << 20
+)
+
+// requestLogMu serializes writes from concurrent requests.
+var requestLogMu sync.Mutex
+
+// logTransport records one line per request in the request log, for
+// 'bgl bugreport'. Like the debug log, it omits the query string since it
+// may carry credentials.
+type logTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	result := "error"
+	if err == nil {
+		result = resp.Status
+	}
+	appendRequestLog(fmt.Sprintf("%s %s %s%s %s (%s)\n", start.Format(time.RFC3339), req.Method, req.URL.Host, req.URL.Path,
+		result, time.Since(start).Round(time.Millisecond)))
+
+	return resp, err
+}
+
+// appendRequestLog appends a line to the request log. Failures are ignored
+// so that logging never breaks a request.
+func appendRequestLog(line string) {
+	stateDir, err := config.GetStateDir()
+	if err != nil {
+		return
+	}
+
+	requestLogMu.Lock()
+	defer requestLogMu.Unlock()
+
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return
+	}
+	path := filepath.Join(stateDir, requestLogFileName)
+	if info, err := os.Stat(path); err == nil && info.Size() > maxRequestLogSize {
+		_ = os.Rename(path, path+".1")
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	_, _ = f.WriteString(line)
+}
+
+// RecentRequests returns the last n lines of the request log, oldest
+// first, including the rotated log if needed.
+func RecentRequests(n int) ([]string, error) {
+	stateDir, err := config.GetStateDir()
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(stateDir, requestLogFileName)
+
+	var lines []string
+	for _, file := range []string{path + ".1", path} {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read request log: %w", err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines, nil
+}
@@ -0,0 +1,262 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/locale"
+)
+
+// GetSpaceInfo retrieves the space's settings.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-space/
+func (c *Client) GetSpaceInfo() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/space")
+}
+
+// GetSpaceActivities retrieves the recent activities of the whole space.
+// The query may set activityTypeId[], minId, maxId, count, and order.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-recent-updates/
+func (c *Client) GetSpaceActivities(query url.Values) ([]byte, error) {
+	path := "/api/v2/space/activities"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// GetSpaceNotification retrieves the space's notification banner.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-space-notification/
+func (c *Client) GetSpaceNotification() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/space/notification")
+}
+
+// UpdateSpaceNotification replaces the space's notification banner. An
+// empty content clears it. Only space administrators may update it.
+// ref: https://developer.nulab.com/docs/backlog/api/2/update-space-notification/
+func (c *Client) UpdateSpaceNotification(content string) ([]byte, error) {
+	data := url.Values{}
+	data.Set("content", content)
+	return c.doPutRequest("/api/v2/space/notification", data)
+}
+
+// SpaceNotification represents the notification banner of a space.
+type SpaceNotification struct {
+	Content string `json:"content"`
+	Updated string `json:"updated"`
+}
+
+// ParseSpaceNotification parses the JSON response into a SpaceNotification
+// struct.
+func ParseSpaceNotification(data []byte) (*SpaceNotification, error) {
+	var notification SpaceNotification
+	if err := json.Unmarshal(data, &notification); err != nil {
+		return nil, fmt.Errorf("failed to parse space notification: %w", err)
+	}
+	return &notification, nil
+}
+
+// FormatSpaceNotificationMarkdown formats a space notification as Markdown.
+func FormatSpaceNotificationMarkdown(notification *SpaceNotification) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Space Notification\n\n")
+	if notification.Content == "" {
+		sb.WriteString("(no notification)\n")
+		return sb.String()
+	}
+	sb.WriteString(notification.Content)
+	sb.WriteString("\n")
+	if notification.Updated != "" {
+		fmt.Fprintf(&sb, "\nUpdated %s\n", locale.DateTimeString(notification.Updated))
+	}
+
+	return sb.String()
+}
+
+// GetSpaceDiskUsage retrieves the disk usage of the space, in total and
+// per project. Only space administrators may read it.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-space-disk-usage/
+func (c *Client) GetSpaceDiskUsage() ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/space/diskUsage")
+}
+
+// SpaceDiskUsage is the disk usage of a space in bytes, by feature, with
+// Details breaking it down per project.
+type SpaceDiskUsage struct {
+	Capacity   int64              `json:"capacity"`
+	Issue      int64              `json:"issue"`
+	Wiki       int64              `json:"wiki"`
+	File       int64              `json:"file"`
+	Subversion int64              `json:"subversion"`
+	Git        int64              `json:"git"`
+	GitLFS     int64              `json:"gitLFS"`
+	Details    []ProjectDiskUsage `json:"details"`
+}
+
+// ParseSpaceDiskUsage parses the JSON response into a SpaceDiskUsage struct.
+func ParseSpaceDiskUsage(data []byte) (*SpaceDiskUsage, error) {
+	var usage SpaceDiskUsage
+	if err := json.Unmarshal(data, &usage); err != nil {
+		return nil, fmt.Errorf("failed to parse space disk usage: %w", err)
+	}
+	return &usage, nil
+}
+
+// projectDiskTotal returns the total disk usage of a project.
+func projectDiskTotal(usage *ProjectDiskUsage) int64 {
+	return usage.Issue + usage.Wiki + usage.File + usage.Subversion + usage.Git + usage.GitLFS
+}
+
+// spaceDiskTotal returns the total disk usage of a space.
+func spaceDiskTotal(usage *SpaceDiskUsage) int64 {
+	return usage.Issue + usage.Wiki + usage.File + usage.Subversion + usage.Git + usage.GitLFS
+}
+
+// FormatSpaceDiskUsageMarkdown formats a space's disk usage as Markdown
+// tables: the total by feature, then each project, largest first.
+// projectKeys maps project IDs to keys; unknown projects are shown by ID.
+func FormatSpaceDiskUsageMarkdown(usage *SpaceDiskUsage, projectKeys map[int]string) string {
+	var sb strings.Builder
+
+	total := spaceDiskTotal(usage)
+
+	sb.WriteString("## Disk Usage\n")
+	sb.WriteString("| Feature | Size |\n")
+	sb.WriteString("|---------|-----:|\n")
+	rows := []struct {
+		name string
+		size int64
+	}{
+		{"Issue attachments", usage.Issue},
+		{"Wiki attachments", usage.Wiki},
+		{"File sharing", usage.File},
+		{"Subversion", usage.Subversion},
+		{"Git", usage.Git},
+		{"Git LFS", usage.GitLFS},
+	}
+	for _, row := range rows {
+		fmt.Fprintf(&sb, "| %s | %s |\n", row.name, locale.Size(row.size))
+	}
+	fmt.Fprintf(&sb, "| **Total** | **%s** |\n", locale.Size(total))
+	if usage.Capacity > 0 {
+		fmt.Fprintf(&sb, "\n%s of %s used (%.1f%%)\n", locale.Size(total), locale.Size(usage.Capacity), float64(total)*100/float64(usage.Capacity))
+	}
+
+	if len(usage.Details) == 0 {
+		return sb.String()
+	}
+
+	details := append([]ProjectDiskUsage(nil), usage.Details...)
+	sort.SliceStable(details, func(i, j int) bool {
+		return projectDiskTotal(&details[i]) > projectDiskTotal(&details[j])
+	})
+
+	sb.WriteString("\n## By Project\n")
+	sb.WriteString("| Project | Issue | Wiki | File | Subversion | Git | Git LFS | Total |\n")
+	sb.WriteString("|---------|------:|-----:|-----:|-----------:|----:|--------:|------:|\n")
+	for _, detail := range details {
+		project, ok := projectKeys[detail.ProjectID]
+		if !ok {
+			project = fmt.Sprintf("id %d", detail.ProjectID)
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s | %s | %s |\n", project,
+			locale.Size(detail.Issue), locale.Size(detail.Wiki), locale.Size(detail.File),
+			locale.Size(detail.Subversion), locale.Size(detail.Git), locale.Size(detail.GitLFS),
+			locale.Size(projectDiskTotal(&detail)))
+	}
+
+	return sb.String()
+}
+
+// licenceLimit formats a licence limit, where 0 means unlimited.
+func licenceLimit(limit int64, format func(int64) string) string {
+	if limit == 0 {
+		return "unlimited"
+	}
+	return format(limit)
+}
+
+// FormatLicenceMarkdown formats a space licence as Markdown, with current
+// usage next to each limit where known. users is the number of users in
+// the space, or -1 if unknown; storage is the disk usage, or nil.
+func FormatLicenceMarkdown(licence *Licence, users int, storage *SpaceDiskUsage) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Licence\n")
+	status := "active"
+	if !licence.Active {
+		status = "inactive"
+	}
+	fmt.Fprintf(&sb, "- Status: %s (licence type %d)\n", status, licence.LicenceTypeID)
+	if licence.StartedOn != "" {
+		fmt.Fprintf(&sb, "- Started: %s\n", locale.DateString(licence.StartedOn))
+	}
+	if licence.LimitDate != "" {
+		fmt.Fprintf(&sb, "- Expires: %s\n", locale.DateString(licence.LimitDate))
+	}
+
+	sb.WriteString("\n## Limits\n")
+	sb.WriteString("| Resource | Used | Limit |\n")
+	sb.WriteString("|----------|-----:|------:|\n")
+	usedUsers := "-"
+	if users >= 0 {
+		usedUsers = locale.Number(int64(users))
+	}
+	fmt.Fprintf(&sb, "| Users | %s | %s |\n", usedUsers, licenceLimit(licence.UserLimit, locale.Number))
+	fmt.Fprintf(&sb, "| Projects | - | %s |\n", licenceLimit(licence.ProjectLimit, locale.Number))
+	fmt.Fprintf(&sb, "| Issues | - | %s |\n", licenceLimit(licence.IssueLimit, locale.Number))
+	usedStorage := "-"
+	if storage != nil {
+		usedStorage = locale.Size(spaceDiskTotal(storage))
+	}
+	fmt.Fprintf(&sb, "| Storage | %s | %s |\n", usedStorage, licenceLimit(licence.StorageLimit, locale.Size))
+	fmt.Fprintf(&sb, "| Attachment size | - | %s |\n", licenceLimit(licence.AttachmentLimit, locale.Size))
+
+	return sb.String()
+}
+
+// Space represents a Backlog space.
+type Space struct {
+	SpaceKey           string `json:"spaceKey"`
+	Name               string `json:"name"`
+	OwnerID            int    `json:"ownerId"`
+	Lang               string `json:"lang"`
+	Timezone           string `json:"timezone"`
+	ReportSendTime     string `json:"reportSendTime"`
+	TextFormattingRule string `json:"textFormattingRule"`
+	Created            string `json:"created"`
+	Updated            string `json:"updated"`
+}
+
+// ParseSpace parses the JSON response into a Space struct.
+func ParseSpace(data []byte) (*Space, error) {
+	var space Space
+	if err := json.Unmarshal(data, &space); err != nil {
+		return nil, fmt.Errorf("failed to parse space: %w", err)
+	}
+	return &space, nil
+}
+
+// FormatSpaceMarkdown formats a space as Markdown. domain is the space's
+// host name and owner the owner's user, or nil if unknown.
+func FormatSpaceMarkdown(domain string, space *Space, owner *User) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "## %s\n", space.Name)
+	fmt.Fprintf(&sb, "- Space: %s (%s)\n", space.SpaceKey, domain)
+	if owner != nil {
+		fmt.Fprintf(&sb, "- Owner: %s (id: %d)\n", owner.Name, owner.ID)
+	} else {
+		fmt.Fprintf(&sb, "- Owner: id %d\n", space.OwnerID)
+	}
+	fmt.Fprintf(&sb, "- Language: %s\n", space.Lang)
+	fmt.Fprintf(&sb, "- Timezone: %s\n", space.Timezone)
+	fmt.Fprintf(&sb, "- Report send time: %s\n", space.ReportSendTime)
+	fmt.Fprintf(&sb, "- Text formatting: %s\n", space.TextFormattingRule)
+	fmt.Fprintf(&sb, "- Created: %s\n", locale.DateTimeString(space.Created))
+
+	return sb.String()
+}
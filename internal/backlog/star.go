@@ -0,0 +1,74 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/locale"
+)
+
+// GetStars retrieves the stars a user has received, newest first. The
+// query may set minId, maxId, count, and order.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-received-star-list/
+func (c *Client) GetStars(userID int, query url.Values) ([]byte, error) {
+	path := "/api/v2/users/" + strconv.Itoa(userID) + "/stars"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// GetStarCount retrieves the number of stars a user has received. The
+// query may set since and until (yyyy-MM-dd).
+// ref: https://developer.nulab.com/docs/backlog/api/2/count-user-received-stars/
+func (c *Client) GetStarCount(userID int, query url.Values) ([]byte, error) {
+	path := "/api/v2/users/" + strconv.Itoa(userID) + "/stars/count"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// Star represents a star given to an issue, comment, wiki page, or pull
+// request. Title and URL identify what was starred.
+type Star struct {
+	ID        int          `json:"id"`
+	Comment   string       `json:"comment"`
+	URL       string       `json:"url"`
+	Title     string       `json:"title"`
+	Presenter *CommentUser `json:"presenter"`
+	Created   string       `json:"created"`
+}
+
+// ParseStars parses the JSON response into a slice of Star structs.
+func ParseStars(data []byte) ([]Star, error) {
+	var stars []Star
+	if err := json.Unmarshal(data, &stars); err != nil {
+		return nil, fmt.Errorf("failed to parse stars: %w", err)
+	}
+	return stars, nil
+}
+
+// FormatStarsMarkdown formats stars as Markdown: when, who gave it, and a
+// link to what was starred.
+func FormatStarsMarkdown(stars []Star) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Star\n")
+	if len(stars) == 0 {
+		sb.WriteString("\nNo stars.\n")
+		return sb.String()
+	}
+	for _, star := range stars {
+		presenter := "(unknown)"
+		if star.Presenter != nil {
+			presenter = star.Presenter.Name
+		}
+		fmt.Fprintf(&sb, "- %s **%s** — [%s](%s)\n", locale.DateTimeString(star.Created), presenter, star.Title, star.URL)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,117 @@
+package backlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/locale"
+)
+
+// GetWatchings retrieves the watch list of a user. The query may set
+// order, sort, count, offset, resourceAlreadyRead, and issueId[].
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-watching-list/
+func (c *Client) GetWatchings(userID int, query url.Values) ([]byte, error) {
+	path := "/api/v2/users/" + strconv.Itoa(userID) + "/watchings"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.doRequest("GET", path)
+}
+
+// GetWatching retrieves a watching by ID.
+// ref: https://developer.nulab.com/docs/backlog/api/2/get-watching/
+func (c *Client) GetWatching(watchingID int) ([]byte, error) {
+	return c.doRequest("GET", "/api/v2/watchings/"+strconv.Itoa(watchingID))
+}
+
+// AddWatching starts watching an issue, with an optional note.
+// ref: https://developer.nulab.com/docs/backlog/api/2/add-watching/
+func (c *Client) AddWatching(issueKeyOrID string, note string) ([]byte, error) {
+	data := url.Values{}
+	data.Set("issueIdOrKey", issueKeyOrID)
+	if note != "" {
+		data.Set("note", note)
+	}
+	return c.doPostRequest("/api/v2/watchings", data)
+}
+
+// UpdateWatching sets the note of a watching.
+// ref: https://developer.nulab.com/docs/backlog/api/2/update-watching/
+func (c *Client) UpdateWatching(watchingID int, note string) ([]byte, error) {
+	data := url.Values{}
+	data.Set("note", note)
+	return c.doPatchRequest("/api/v2/watchings/"+strconv.Itoa(watchingID), data)
+}
+
+// DeleteWatching stops a watching.
+// ref: https://developer.nulab.com/docs/backlog/api/2/delete-watching/
+func (c *Client) DeleteWatching(watchingID int) ([]byte, error) {
+	return c.doDeleteRequest("/api/v2/watchings/"+strconv.Itoa(watchingID), url.Values{})
+}
+
+// Watching represents an issue on a user's watch list.
+type Watching struct {
+	ID                  int    `json:"id"`
+	ResourceAlreadyRead bool   `json:"resourceAlreadyRead"`
+	Note                string `json:"note"`
+	Type                string `json:"type"`
+	Issue               *Issue `json:"issue"`
+	LastContentUpdated  string `json:"lastContentUpdated"`
+	Created             string `json:"created"`
+	Updated             string `json:"updated"`
+}
+
+// ParseWatching parses the JSON response into a Watching struct.
+func ParseWatching(data []byte) (*Watching, error) {
+	var watching Watching
+	if err := json.Unmarshal(data, &watching); err != nil {
+		return nil, fmt.Errorf("failed to parse watching: %w", err)
+	}
+	return &watching, nil
+}
+
+// ParseWatchings parses the JSON response into a slice of Watching structs.
+func ParseWatchings(data []byte) ([]Watching, error) {
+	var watchings []Watching
+	if err := json.Unmarshal(data, &watchings); err != nil {
+		return nil, fmt.Errorf("failed to parse watchings: %w", err)
+	}
+	return watchings, nil
+}
+
+// FormatWatchingsMarkdown formats a watch list as Markdown. Watchings
+// updated since they were last read are marked with a dot.
+func FormatWatchingsMarkdown(watchings []Watching) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Watching\n")
+	if len(watchings) == 0 {
+		sb.WriteString("\nNot watching any issues.\n")
+		return sb.String()
+	}
+	for _, watching := range watchings {
+		mark := " "
+		if !watching.ResourceAlreadyRead {
+			mark = "●"
+		}
+		target := "(deleted issue)"
+		if watching.Issue != nil {
+			target = watching.Issue.IssueKey + " " + watching.Issue.Summary
+			if watching.Issue.Status != nil {
+				target += " (" + watching.Issue.Status.Name + ")"
+			}
+		}
+		fmt.Fprintf(&sb, "- %s %s (id: %d)\n", mark, target, watching.ID)
+		if watching.Note != "" {
+			fmt.Fprintf(&sb, "  - Note: %s\n", watching.Note)
+		}
+		if watching.LastContentUpdated != "" {
+			fmt.Fprintf(&sb, "  - Updated: %s\n", locale.DateTimeString(watching.LastContentUpdated))
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,165 @@
+package bugreport
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/config"
+)
+
+// BuildInfo contains the version information of the running binary.
+type BuildInfo struct {
+	Version string
+	Commit  string
+	Date    string
+}
+
+// DefaultLogLines is the number of request log entries included by default.
+const DefaultLogLines = 50
+
+// Options contains options for the bugreport command.
+type Options struct {
+	Output   string
+	Command  []string
+	LogLines int
+}
+
+// Generate writes a sanitized bug report file, including the last
+// LogLines entries of the request log. If a command is given, it is re-run
+// with BGL_DEBUG=1 and its output is included as a trace.
+func Generate(build BuildInfo, opts Options) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("# bgl bug report\n\n")
+	fmt.Fprintf(&sb, "Generated: %s\n\n", time.Now().Format(time.RFC3339))
+
+	sb.WriteString("## Version\n")
+	fmt.Fprintf(&sb, "- Version: %s\n", build.Version)
+	fmt.Fprintf(&sb, "- Commit: %s\n", build.Commit)
+	fmt.Fprintf(&sb, "- Built: %s\n\n", build.Date)
+
+	sb.WriteString("## Environment\n")
+	fmt.Fprintf(&sb, "- OS/Arch: %s/%s\n", runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(&sb, "- Go: %s\n\n", runtime.Version())
+
+	sb.WriteString("## Config\n")
+	configPath, err := config.GetConfigPath()
+	if err == nil {
+		fmt.Fprintf(&sb, "- Path: %s (exists: %t)\n", configPath, config.Exists())
+	}
+	fmt.Fprintf(&sb, "- Space: %s\n", valueOrNone(cfg.Space))
+	fmt.Fprintf(&sb, "- Access token: %s\n", redact(cfg.AccessToken))
+	fmt.Fprintf(&sb, "- Refresh token: %s\n", redact(cfg.RefreshToken))
+	fmt.Fprintf(&sb, "- API key: %s\n", redact(cfg.APIKey))
+	if cfg.ExpiresAt > 0 {
+		fmt.Fprintf(&sb, "- Token expires: %s\n", time.UnixMilli(cfg.ExpiresAt).Format(time.RFC3339))
+	}
+	fmt.Fprintf(&sb, "- Default project: %s\n", valueOrNone(cfg.DefaultProject))
+	fmt.Fprintf(&sb, "- Editor: %s\n", valueOrNone(cfg.Editor))
+	fmt.Fprintf(&sb, "- Pager: %s\n", valueOrNone(cfg.Pager))
+	fmt.Fprintf(&sb, "- Request log: %t\n", cfg.RequestLog)
+
+	if opts.LogLines > 0 {
+		sb.WriteString("\n## Recent Requests\n\n")
+		lines, err := backlog.RecentRequests(opts.LogLines)
+		switch {
+		case err != nil:
+			fmt.Fprintf(&sb, "%v\n", err)
+		case len(lines) == 0 && !cfg.RequestLog:
+			sb.WriteString("(none; the request log is off, set \"request_log\": true in the config to record requests)\n")
+		case len(lines) == 0:
+			sb.WriteString("(none)\n")
+		default:
+			sb.WriteString("```\n")
+			sb.WriteString(sanitize(strings.Join(lines, "\n"), cfg))
+			sb.WriteString("\n```\n")
+		}
+	}
+
+	if len(opts.Command) > 0 {
+		sb.WriteString("\n## Command Trace\n\n")
+		fmt.Fprintf(&sb, "Command: `bgl %s`\n\n", strings.Join(opts.Command, " "))
+		output, exitCode := runTraced(opts.Command)
+		fmt.Fprintf(&sb, "Exit code: %d\n\n", exitCode)
+		sb.WriteString("```\n")
+		sb.WriteString(sanitize(output, cfg))
+		if !strings.HasSuffix(output, "\n") {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("```\n")
+	}
+
+	path := opts.Output
+	if path == "" {
+		path = fmt.Sprintf("bgl-bugreport-%s.md", time.Now().Format("20060102-150405"))
+	}
+
+	if err := os.WriteFile(path, []byte(sb.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Printf("Bug report written to %s\n", path)
+	fmt.Println("Please review it before attaching it to an issue.")
+	return nil
+}
+
+// runTraced runs bgl with the given arguments and debug tracing enabled,
+// returning its combined output and exit code.
+func runTraced(args []string) (string, int) {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Sprintf("failed to locate executable: %v", err), -1
+	}
+
+	var out bytes.Buffer
+	cmd := exec.Command(exe, args...)
+	cmd.Env = append(os.Environ(), "BGL_DEBUG=1")
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return out.String(), exitErr.ExitCode()
+		}
+		return out.String() + err.Error(), -1
+	}
+	return out.String(), 0
+}
+
+// sanitize removes credentials from command output.
+func sanitize(s string, cfg *config.Config) string {
+	for _, secret := range []string{cfg.AccessToken, cfg.RefreshToken, cfg.APIKey} {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, "[REDACTED]")
+		}
+	}
+	return s
+}
+
+// redact reports whether a secret is set without revealing it.
+func redact(secret string) string {
+	if secret == "" {
+		return "(not set)"
+	}
+	return "(set, redacted)"
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "(not set)"
+	}
+	return s
+}
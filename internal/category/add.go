@@ -0,0 +1,58 @@
+package category
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// AddOptions contains options for the add command.
+type AddOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// Add adds a category to a project.
+func Add(projectIDOrKey string, name string, opts AddOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		confirm, err := prompt.Confirm("Add Category?", "Add", []string{
+			"Space: " + client.GetSpace(),
+			"Project: " + projectIDOrKey,
+			"Name: " + name,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	data, err := client.AddCategory(projectIDOrKey, name)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	category, err := backlog.ParseCategory(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Category added: %s (id: %d)\n", category.Name, category.ID)
+	return nil
+}
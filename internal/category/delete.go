@@ -0,0 +1,59 @@
+package category
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// DeleteOptions contains options for the delete command.
+type DeleteOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// Delete deletes a category, given by ID or name.
+func Delete(projectIDOrKey string, categoryIDOrName string, opts DeleteOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	category, err := findCategory(client, projectIDOrKey, categoryIDOrName)
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		confirm, err := prompt.Confirm("Delete Category?", "Delete", []string{
+			"Space: " + client.GetSpace(),
+			"Project: " + projectIDOrKey,
+			fmt.Sprintf("Category: %s (id: %d)", category.Name, category.ID),
+			"Issues in this category lose it.",
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	data, err := client.DeleteCategory(projectIDOrKey, category.ID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fmt.Printf("Category deleted: %s\n", category.Name)
+	return nil
+}
@@ -0,0 +1,71 @@
+package category
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// RenameOptions contains options for the rename command.
+type RenameOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// Rename renames a category, given by ID or name.
+func Rename(projectIDOrKey string, categoryIDOrName string, newName string, opts RenameOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	category, err := findCategory(client, projectIDOrKey, categoryIDOrName)
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		confirm, err := prompt.Confirm("Rename Category?", "Rename", []string{
+			"Space: " + client.GetSpace(),
+			"Project: " + projectIDOrKey,
+			fmt.Sprintf("Category: %s → %s", category.Name, newName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	data, err := client.UpdateCategory(projectIDOrKey, category.ID, newName)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fmt.Printf("Category renamed: %s → %s\n", category.Name, newName)
+	return nil
+}
+
+// findCategory looks up a project's category by ID or name.
+func findCategory(client *backlog.Client, projectIDOrKey string, categoryIDOrName string) (*backlog.Category, error) {
+	data, err := client.GetCategories(projectIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := backlog.ParseCategories(data)
+	if err != nil {
+		return nil, err
+	}
+	return backlog.FindCategory(categories, categoryIDOrName)
+}
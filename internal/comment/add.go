@@ -1,22 +1,65 @@
 package comment
 
 import (
-	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/charmbracelet/huh"
 	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/editor"
+	"github.com/dannygim/bgl/internal/htmlmd"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/queue"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
 )
 
 // AddOptions contains options for the add command.
 type AddOptions struct {
-	Raw bool
-	Yes bool
+	Raw      bool
+	Yes      bool
+	Notify   string
+	Scope    string
+	KeepHTML bool
+	BodyFile string
+	Editor   bool
 }
 
 // Add adds a comment to an issue.
 func Add(issueKeyOrID string, content string, opts AddOptions) error {
+	// Read content from a file, or stdin for "-"
+	if content == "" && opts.BodyFile != "" {
+		var data []byte
+		var err error
+		if opts.BodyFile == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(opts.BodyFile)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read comment body: %w", err)
+		}
+
+		content = strings.TrimRight(string(data), "\n")
+		if strings.TrimSpace(content) == "" {
+			return fmt.Errorf("comment content cannot be empty")
+		}
+	}
+
+	// Compose in the editor when asked, or by default when one is set up
+	if content == "" && (opts.Editor || (editor.Configured() && editor.IsTerminal())) {
+		composed, err := composeInEditor(issueKeyOrID)
+		if err != nil {
+			return err
+		}
+		if composed == "" {
+			return fmt.Errorf("comment content cannot be empty")
+		}
+		content = composed
+	}
+
 	// If content is empty, prompt for input
 	if content == "" {
 		if err := huh.NewText().
@@ -32,48 +75,63 @@ func Add(issueKeyOrID string, content string, opts AddOptions) error {
 		}
 	}
 
-	// Show confirmation unless --yes is specified
-	if !opts.Yes {
-		var confirm bool
-		if err := huh.NewConfirm().
-			Title("Add Comment?").
-			Description(fmt.Sprintf("Issue: %s\nContent:\n%s", issueKeyOrID, content)).
-			Affirmative("Confirm").
-			Negative("Cancel").
-			Value(&confirm).
-			Run(); err != nil {
-			return fmt.Errorf("confirmation failed: %w", err)
+	// Convert rich text pasted as HTML so it doesn't post as raw tags
+	converted := false
+	if !opts.KeepHTML && htmlmd.IsHTML(content) {
+		markdown, err := htmlmd.Convert(content)
+		if err != nil {
+			return err
 		}
+		content = markdown
+		converted = true
 
-		if !confirm {
-			fmt.Println("Cancelled.")
-			return nil
+		// --yes skips the preview, so show what will be posted instead
+		if opts.Yes {
+			fmt.Fprintf(os.Stderr, "Converted HTML to Markdown (use --keep-html to post it as is):\n%s\n\n", content)
 		}
 	}
 
+	if err := secrets.Check(content); err != nil {
+		return err
+	}
+
 	client, err := backlog.NewClient()
 	if err != nil {
 		return err
 	}
 
-	data, err := client.AddComment(issueKeyOrID, content)
+	notified, err := client.ResolveNotifiedUsers(issueKeyOrID, opts.Scope, opts.Notify)
 	if err != nil {
 		return err
 	}
+	notifiedUserIDs := backlog.UserIDs(notified)
+	if opts.Yes {
+		fmt.Fprintf(os.Stderr, "Notifying: %s\n", backlog.DescribeNotified(opts.Scope, opts.Notify, notified))
+	}
 
-	if opts.Raw {
-		// Pretty print JSON
-		var prettyJSON map[string]any
-		if err := json.Unmarshal(data, &prettyJSON); err != nil {
-			fmt.Println(string(data))
-			return nil
-		}
-		formatted, err := json.MarshalIndent(prettyJSON, "", "  ")
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		confirm, err := prompt.Confirm("Add Comment?", "Confirm", []string{confirmDescription(client.GetSpace(), issueKeyOrID, content, backlog.DescribeNotified(opts.Scope, opts.Notify, notified), converted)})
 		if err != nil {
-			fmt.Println(string(data))
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
 			return nil
 		}
-		fmt.Println(string(formatted))
+	}
+
+	data, err := client.AddComment(issueKeyOrID, content, notifiedUserIDs)
+	if err != nil {
+		if queue.IsConnectivityError(err) {
+			return offerQueue(issueKeyOrID, content, notifiedUserIDs, opts, err)
+		}
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
 		return nil
 	}
 
@@ -85,10 +143,77 @@ func Add(issueKeyOrID string, content string, opts AddOptions) error {
 
 	// Build and display the comment URL
 	space := client.GetSpace()
-	commentURL := fmt.Sprintf("https://%s/view/%s#comment-%d", space, issueKeyOrID, comment.ID)
+	commentURL := backlog.CommentURL(space, issueKeyOrID, comment.ID)
 
 	fmt.Println("Comment added successfully!")
 	fmt.Printf("URL: %s\n", commentURL)
 
 	return nil
 }
+
+// composeInEditor opens the editor with the issue summary shown in the
+// commented-out help. If the issue cannot be fetched, only the key is shown.
+func composeInEditor(issueKeyOrID string) (string, error) {
+	help := []string{"", fmt.Sprintf("Commenting on %s", issueKeyOrID)}
+
+	if client, err := backlog.NewClient(); err == nil {
+		if data, err := client.GetIssue(issueKeyOrID); err == nil {
+			if issue, err := backlog.ParseIssue(data); err == nil {
+				help = []string{
+					"",
+					fmt.Sprintf("Commenting on %s: %s", issue.IssueKey, issue.Summary),
+				}
+				if issue.Status != nil {
+					help = append(help, fmt.Sprintf("Status: %s", issue.Status.Name))
+				}
+				if issue.Assignee != nil {
+					help = append(help, fmt.Sprintf("Assignee: %s", issue.Assignee.Name))
+				}
+			}
+		}
+	}
+
+	return editor.EditWithHelp("", help)
+}
+
+// offerQueue offers to queue a comment that failed to post because of a
+// connectivity error. With --yes, the comment is queued without asking.
+func offerQueue(issueKeyOrID string, content string, notifiedUserIDs []string, opts AddOptions, postErr error) error {
+	fmt.Printf("Failed to post comment: %v\n", postErr)
+
+	if !opts.Yes {
+		var queueIt bool
+		if err := huh.NewConfirm().
+			Title("Queue comment?").
+			Description("Save the comment locally and post it later with 'bgl queue flush'").
+			Affirmative("Queue").
+			Negative("Discard").
+			Value(&queueIt).
+			Run(); err != nil {
+			return fmt.Errorf("confirmation failed: %w", err)
+		}
+
+		if !queueIt {
+			return postErr
+		}
+	}
+
+	if err := queue.Add(issueKeyOrID, content, notifiedUserIDs); err != nil {
+		return fmt.Errorf("failed to queue comment: %w", err)
+	}
+
+	fmt.Println("Comment queued. Run 'bgl queue flush' to post it.")
+	return nil
+}
+
+// confirmDescription builds the confirmation prompt text for a new comment.
+func confirmDescription(space string, issueKeyOrID string, content string, notify string, converted bool) string {
+	description := fmt.Sprintf("Space: %s\nIssue: %s\n", space, issueKeyOrID)
+	if notify != "" {
+		description += fmt.Sprintf("Notify: %s\n", notify)
+	}
+	if converted {
+		return description + fmt.Sprintf("Content (converted from HTML):\n%s", content)
+	}
+	return description + fmt.Sprintf("Content:\n%s", content)
+}
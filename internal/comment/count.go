@@ -0,0 +1,39 @@
+package comment
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// CountOptions contains options for the count command.
+type CountOptions struct {
+	Raw bool
+}
+
+// Count prints the number of comments on an issue as a bare integer.
+func Count(issueKeyOrID string, opts CountOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetCommentCount(issueKeyOrID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	count, err := backlog.ParseCount(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(count.Count)
+	return nil
+}
@@ -0,0 +1,64 @@
+package comment
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// DeleteOptions contains options for the delete command.
+type DeleteOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// Delete deletes a comment after confirming its content.
+func Delete(issueKeyOrID string, commentID string, opts DeleteOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		data, err := client.GetComment(issueKeyOrID, commentID)
+		if err != nil {
+			return err
+		}
+		comment, err := backlog.ParseComment(data)
+		if err != nil {
+			return err
+		}
+
+		confirm, err := prompt.Confirm("Delete Comment?", "Delete", []string{
+			"Space: " + client.GetSpace(),
+			"Issue: " + issueKeyOrID,
+			"Comment: " + commentID,
+			"Content:",
+			comment.Content,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	data, err := client.DeleteComment(issueKeyOrID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fmt.Println("Comment deleted successfully!")
+	return nil
+}
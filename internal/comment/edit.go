@@ -0,0 +1,97 @@
+package comment
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/editor"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// EditOptions contains options for the edit command.
+type EditOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// Edit updates the content of a comment. If content is empty, the editor is
+// opened prefilled with the current content.
+func Edit(issueKeyOrID string, commentID string, content string, opts EditOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if content == "" {
+		data, err := client.GetComment(issueKeyOrID, commentID)
+		if err != nil {
+			return err
+		}
+		current, err := backlog.ParseComment(data)
+		if err != nil {
+			return err
+		}
+
+		content, err = editor.Edit(current.Content)
+		if err != nil {
+			return err
+		}
+		content = strings.TrimRight(content, "\n")
+
+		if strings.TrimSpace(content) == "" {
+			return fmt.Errorf("comment content cannot be empty")
+		}
+		if content == current.Content {
+			fmt.Println("No changes.")
+			return nil
+		}
+	}
+
+	if err := secrets.Check(content); err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		confirm, err := prompt.Confirm("Update Comment?", "Confirm", []string{
+			"Space: " + client.GetSpace(),
+			"Issue: " + issueKeyOrID,
+			"Comment: " + commentID,
+			"Content:",
+			content,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	data, err := client.UpdateComment(issueKeyOrID, commentID, content)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	comment, err := backlog.ParseComment(data)
+	if err != nil {
+		return err
+	}
+
+	commentURL := backlog.CommentURL(client.GetSpace(), issueKeyOrID, comment.ID)
+
+	fmt.Println("Comment updated successfully!")
+	fmt.Printf("URL: %s\n", commentURL)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package comment
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// NotificationsOptions contains options for the notifications command.
+type NotificationsOptions struct {
+	Raw bool
+}
+
+// Notifications displays who was notified about a comment.
+func Notifications(issueKeyOrID string, commentID string, opts NotificationsOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetCommentNotifications(issueKeyOrID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	notifications, err := backlog.ParseCommentNotifications(data)
+	if err != nil {
+		return err
+	}
+
+	if len(notifications) == 0 {
+		fmt.Println("No one was notified.")
+		return nil
+	}
+
+	markdown := backlog.FormatCommentNotificationsMarkdown(notifications)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -0,0 +1,74 @@
+package comment
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/editor"
+	"github.com/dannygim/bgl/internal/locale"
+)
+
+// ReplyOptions contains options for the reply command.
+type ReplyOptions struct {
+	Raw    bool
+	Yes    bool
+	Notify string
+	Scope  string
+}
+
+// Reply opens the editor prefilled with a quote of a comment and posts the
+// result as a new comment.
+func Reply(issueKeyOrID string, commentID string, opts ReplyOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetComment(issueKeyOrID, commentID)
+	if err != nil {
+		return err
+	}
+	quoted, err := backlog.ParseComment(data)
+	if err != nil {
+		return err
+	}
+
+	initial := quote(quoted) + "\n\n"
+	help := []string{"", fmt.Sprintf("Replying to comment %d on %s", quoted.ID, issueKeyOrID)}
+
+	content, err := editor.EditWithHelp(initial, help)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(content) == strings.TrimSpace(initial) {
+		return fmt.Errorf("reply is empty")
+	}
+
+	return Add(issueKeyOrID, content, AddOptions{
+		Raw:    opts.Raw,
+		Yes:    opts.Yes,
+		Notify: opts.Notify,
+		Scope:  opts.Scope,
+	})
+}
+
+// quote formats a comment as a Markdown quote with an author/date header.
+func quote(comment *backlog.Comment) string {
+	author := "(unknown)"
+	if comment.CreatedUser != nil {
+		author = comment.CreatedUser.Name
+	}
+	created := locale.DateTimeString(comment.Created)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "On %s, %s wrote:\n", created, author)
+	for line := range strings.SplitSeq(strings.TrimRight(comment.Content, "\n"), "\n") {
+		if line == "" {
+			sb.WriteString(">\n")
+			continue
+		}
+		sb.WriteString("> " + line + "\n")
+	}
+	return strings.TrimRight(sb.String(), "\n")
+}
@@ -0,0 +1,99 @@
+package comment
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// SearchOptions contains options for the search command.
+type SearchOptions struct {
+	Raw bool
+}
+
+// Search fetches all comments on an issue and displays those whose content
+// contains the query (case-insensitive), with the hits highlighted.
+func Search(issueKeyOrID string, query string, opts SearchOptions) error {
+	if strings.TrimSpace(query) == "" {
+		return fmt.Errorf("search query cannot be empty")
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := fetchComments(client, issueKeyOrID, ViewOptions{All: true, Order: "asc"}, func([]byte) error { return nil })
+	if err != nil {
+		return err
+	}
+
+	comments, err := backlog.ParseComments(data)
+	if err != nil {
+		return err
+	}
+	// --raw prints the API's own items, not the parsed subset of fields
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return fmt.Errorf("failed to parse comments: %w", err)
+	}
+
+	matches := []backlog.Comment{}
+	rawMatches := []json.RawMessage{}
+	for i, c := range comments {
+		if strings.Contains(strings.ToLower(c.Content), strings.ToLower(query)) {
+			matches = append(matches, c)
+			rawMatches = append(rawMatches, items[i])
+		}
+	}
+
+	if opts.Raw {
+		formatted, err := json.Marshal(rawMatches)
+		if err != nil {
+			return err
+		}
+		render.JSON(formatted)
+		return nil
+	}
+
+	if len(matches) == 0 {
+		fmt.Printf("No comments match %q.\n", query)
+		return nil
+	}
+
+	for i := range matches {
+		matches[i].Content = highlight(matches[i].Content, query)
+	}
+
+	markdown := fmt.Sprintf("%d of %d comments match %q\n\n---\n\n", len(matches), len(comments), query) +
+		backlog.FormatCommentsMarkdown(matches)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// highlight wraps each case-insensitive occurrence of query in s in bold.
+func highlight(s string, query string) string {
+	lower := strings.ToLower(s)
+	needle := strings.ToLower(query)
+
+	// Lowercasing can change byte lengths; skip highlighting then
+	if len(lower) != len(s) {
+		return s
+	}
+
+	var sb strings.Builder
+	for {
+		i := strings.Index(lower, needle)
+		if i < 0 {
+			sb.WriteString(s)
+			return sb.String()
+		}
+		sb.WriteString(s[:i])
+		sb.WriteString("**" + s[i:i+len(needle)] + "**")
+		s, lower = s[i+len(needle):], lower[i+len(needle):]
+	}
+}
@@ -0,0 +1,36 @@
+package comment
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/config"
+)
+
+// issueKeyPattern matches an issue key such as PROJECT-123.
+var issueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*-[0-9]+$`)
+
+// URL prints the permalink of a comment. It only validates its arguments
+// locally and makes no API call.
+func URL(issueKey string, commentID string) error {
+	if !issueKeyPattern.MatchString(issueKey) {
+		return fmt.Errorf("invalid issue key: %s (expected e.g. PROJECT-123)", issueKey)
+	}
+	id, err := strconv.Atoi(commentID)
+	if err != nil || id < 1 {
+		return fmt.Errorf("invalid comment ID: %s", commentID)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg.Space == "" {
+		return fmt.Errorf("not logged in. Please run 'bgl init' or 'bgl auth login' first")
+	}
+
+	fmt.Println(backlog.CommentURL(cfg.Space, issueKey, id))
+	return nil
+}
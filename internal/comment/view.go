@@ -3,14 +3,27 @@ package comment
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/charmbracelet/glamour"
 	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/period"
+	"github.com/dannygim/bgl/internal/render"
 )
 
 // ViewOptions contains options for the view command.
 type ViewOptions struct {
-	Raw bool
+	Raw    bool
+	Format string
+	All    bool
+	Count  int
+	Order  string
+	Since  string
+	Until  string
+	Period string
+	Author string
 }
 
 // ViewList displays comments for an issue.
@@ -20,25 +33,28 @@ func ViewList(issueKeyOrID string, opts ViewOptions) error {
 		return err
 	}
 
-	data, err := client.GetComments(issueKeyOrID)
+	// JSON Lines are printed as each page is fetched
+	data, err := fetchComments(client, issueKeyOrID, opts, func(page []byte) error {
+		if opts.Format != "jsonl" {
+			return nil
+		}
+		lines, err := backlog.FormatJSONL(page)
+		if err != nil {
+			return err
+		}
+		fmt.Print(lines)
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
+	if opts.Format == "jsonl" {
+		return nil
+	}
+
 	if opts.Raw {
-		// Pretty print JSON
-		var prettyJSON []any
-		if err := json.Unmarshal(data, &prettyJSON); err != nil {
-			// If pretty print fails, output raw
-			fmt.Println(string(data))
-			return nil
-		}
-		formatted, err := json.MarshalIndent(prettyJSON, "", "  ")
-		if err != nil {
-			fmt.Println(string(data))
-			return nil
-		}
-		fmt.Println(string(formatted))
+		render.JSON(data)
 		return nil
 	}
 
@@ -54,24 +70,171 @@ func ViewList(issueKeyOrID string, opts ViewOptions) error {
 
 	markdown := backlog.FormatCommentsMarkdown(comments)
 
-	renderer, err := glamour.NewTermRenderer(
-		glamour.WithAutoStyle(),
-		glamour.WithWordWrap(100),
-	)
+	render.Markdown(markdown)
+	return nil
+}
+
+// fetchComments fetches the comment list and returns it as a JSON array.
+// With opts.All, it follows pages using minId/maxId until no new comments
+// are returned. onPage is called with each page as it arrives.
+func fetchComments(client *backlog.Client, issueKeyOrID string, opts ViewOptions, onPage func([]byte) error) ([]byte, error) {
+	inRange, err := dateRange(opts.Since, opts.Until, opts.Period)
 	if err != nil {
-		// Fallback to plain output if renderer fails
-		fmt.Print(markdown)
-		return nil
+		return nil, err
+	}
+	// A date range or author can match any part of the thread, so fetch all
+	// of it. Count then limits the matching comments instead of the page.
+	limit := 0
+	if opts.Since != "" || opts.Until != "" || opts.Period != "" || opts.Author != "" {
+		opts.All = true
+		limit = opts.Count
+		opts.Count = 0
 	}
 
-	rendered, err := renderer.Render(markdown)
-	if err != nil {
-		fmt.Print(markdown)
-		return nil
+	query := url.Values{}
+	if opts.Count > 0 {
+		query.Set("count", strconv.Itoa(opts.Count))
+	} else if opts.All {
+		query.Set("count", "100")
+	}
+	if opts.Order != "" {
+		query.Set("order", opts.Order)
 	}
 
-	fmt.Print(rendered)
-	return nil
+	if !opts.All {
+		data, err := client.GetComments(issueKeyOrID, query)
+		if err != nil {
+			return nil, err
+		}
+		return data, onPage(data)
+	}
+
+	all := []json.RawMessage{}
+	seen := map[int]bool{}
+	for {
+		data, err := client.GetComments(issueKeyOrID, query)
+		if err != nil {
+			return nil, err
+		}
+
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, fmt.Errorf("failed to parse comments: %w", err)
+		}
+
+		// Whether minId/maxId are inclusive or not, skip anything already fetched
+		var page []json.RawMessage
+		fetched := 0
+		lastID := 0
+		for _, item := range items {
+			var c struct {
+				ID          int                  `json:"id"`
+				Created     string               `json:"created"`
+				CreatedUser *backlog.CommentUser `json:"createdUser"`
+			}
+			if err := json.Unmarshal(item, &c); err != nil {
+				return nil, fmt.Errorf("failed to parse comment: %w", err)
+			}
+			lastID = c.ID
+			if seen[c.ID] {
+				continue
+			}
+			seen[c.ID] = true
+			fetched++
+			if inRange(c.Created) && byAuthor(c.CreatedUser, opts.Author) {
+				page = append(page, item)
+			}
+		}
+		if fetched == 0 {
+			break
+		}
+
+		done := false
+		if limit > 0 && len(all)+len(page) >= limit {
+			page = page[:limit-len(all)]
+			done = true
+		}
+
+		if len(page) > 0 {
+			pageData, err := json.Marshal(page)
+			if err != nil {
+				return nil, err
+			}
+			if err := onPage(pageData); err != nil {
+				return nil, err
+			}
+			all = append(all, page...)
+		}
+		if done {
+			break
+		}
+
+		if opts.Order == "asc" {
+			query.Set("minId", strconv.Itoa(lastID))
+		} else {
+			query.Set("maxId", strconv.Itoa(lastID))
+		}
+	}
+
+	return json.Marshal(all)
+}
+
+// byAuthor reports whether a comment was written by author, matched
+// case-insensitively against the user's name, user ID, or mail address.
+// An empty author matches everyone.
+func byAuthor(user *backlog.CommentUser, author string) bool {
+	if author == "" {
+		return true
+	}
+	if user == nil {
+		return false
+	}
+	return strings.EqualFold(user.Name, author) || strings.EqualFold(user.UserID, author) ||
+		strings.EqualFold(user.MailAddress, author)
+}
+
+// dateRange returns a filter reporting whether a comment's created datetime
+// falls between since and until (yyyy-MM-dd, inclusive, local time) and
+// within the period, if any. An empty bound is open.
+func dateRange(since string, until string, periodName string) (func(created string) bool, error) {
+	var from, to time.Time
+	if since != "" {
+		t, err := time.ParseInLocation("2006-01-02", since, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --since date %q (expected yyyy-MM-dd)", since)
+		}
+		from = t
+	}
+	if until != "" {
+		t, err := time.ParseInLocation("2006-01-02", until, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --until date %q (expected yyyy-MM-dd)", until)
+		}
+		to = t.AddDate(0, 0, 1)
+	}
+	if periodName != "" {
+		p, err := period.Parse(periodName, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if from.IsZero() || p.Start.After(from) {
+			from = p.Start
+		}
+		if to.IsZero() || p.End.Before(to) {
+			to = p.End
+		}
+	}
+
+	return func(created string) bool {
+		if from.IsZero() && to.IsZero() {
+			return true
+		}
+		t, err := time.Parse(time.RFC3339, created)
+		if err != nil {
+			return false
+		}
+		return (from.IsZero() || !t.Before(from)) && (to.IsZero() || t.Before(to))
+	}, nil
 }
 
 // View displays a single comment.
@@ -86,20 +249,17 @@ func View(issueKeyOrID string, commentID string, opts ViewOptions) error {
 		return err
 	}
 
-	if opts.Raw {
-		// Pretty print JSON
-		var prettyJSON map[string]any
-		if err := json.Unmarshal(data, &prettyJSON); err != nil {
-			// If pretty print fails, output raw
-			fmt.Println(string(data))
-			return nil
-		}
-		formatted, err := json.MarshalIndent(prettyJSON, "", "  ")
+	if opts.Format == "jsonl" {
+		lines, err := backlog.FormatJSONL(data)
 		if err != nil {
-			fmt.Println(string(data))
-			return nil
+			return err
 		}
-		fmt.Println(string(formatted))
+		fmt.Print(lines)
+		return nil
+	}
+
+	if opts.Raw {
+		render.JSON(data)
 		return nil
 	}
 
@@ -110,22 +270,6 @@ func View(issueKeyOrID string, commentID string, opts ViewOptions) error {
 
 	markdown := backlog.FormatCommentMarkdown(comment)
 
-	renderer, err := glamour.NewTermRenderer(
-		glamour.WithAutoStyle(),
-		glamour.WithWordWrap(100),
-	)
-	if err != nil {
-		// Fallback to plain output if renderer fails
-		fmt.Print(markdown)
-		return nil
-	}
-
-	rendered, err := renderer.Render(markdown)
-	if err != nil {
-		fmt.Print(markdown)
-		return nil
-	}
-
-	fmt.Print(rendered)
+	render.Markdown(markdown)
 	return nil
 }
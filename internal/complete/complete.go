@@ -0,0 +1,201 @@
+package complete
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/config"
+)
+
+const (
+	// cacheFileName is the name of the completion cache in the state dir.
+	cacheFileName = "completion.json"
+	// lockFileName marks a background refresh in progress.
+	lockFileName = "completion.lock"
+)
+
+const (
+	// budget is the hard time limit for answering a completion request.
+	budget = 50 * time.Millisecond
+	// staleAfter is the age after which a background refresh is started.
+	staleAfter = time.Hour
+	// lockTimeout is the age after which a refresh lock is considered dead.
+	lockTimeout = time.Minute
+)
+
+// Cache holds the metadata used by shell completions and prompts.
+type Cache struct {
+	Space     string    `json:"space"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Projects  []Project `json:"projects"`
+}
+
+// Project is a cached project entry.
+type Project struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// Kinds lists the values accepted by Complete.
+var Kinds = []string{"space", "projects"}
+
+func getPath(name string) (string, error) {
+	stateDir, err := config.GetStateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(stateDir, name), nil
+}
+
+func load() (*Cache, error) {
+	path, err := getPath(cacheFileName)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cache Cache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, err
+	}
+	return &cache, nil
+}
+
+// Complete prints the cached values of kind, one per line. It never calls
+// the API: a missing or stale cache starts a detached refresh and answers
+// with what is cached, or nothing, within the time budget.
+func Complete(kind string) error {
+	switch kind {
+	case "space", "projects":
+	default:
+		return fmt.Errorf("unknown completion kind: %s", kind)
+	}
+
+	values := make(chan []string, 1)
+
+	go func() {
+		cfg, err := config.Load()
+		if err != nil {
+			values <- nil
+			return
+		}
+
+		cache, err := load()
+		if err != nil || cache.Space != cfg.Space || time.Since(cache.UpdatedAt) > staleAfter {
+			refreshInBackground()
+		}
+
+		var lines []string
+		switch kind {
+		case "space":
+			if cfg.Space != "" {
+				lines = append(lines, cfg.Space)
+			}
+		case "projects":
+			if cache != nil && cache.Space == cfg.Space {
+				for _, project := range cache.Projects {
+					lines = append(lines, project.Key)
+				}
+			}
+		}
+		values <- lines
+	}()
+
+	select {
+	case lines := <-values:
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+	case <-time.After(budget):
+		// Answer with nothing rather than stall the shell
+	}
+	return nil
+}
+
+// refreshInBackground starts a detached 'bgl __complete --refresh' unless
+// one is already running.
+func refreshInBackground() {
+	lockPath, err := getPath(lockFileName)
+	if err != nil {
+		return
+	}
+	if info, err := os.Stat(lockPath); err == nil && time.Since(info.ModTime()) < lockTimeout {
+		return
+	}
+
+	stateDir, err := config.GetStateDir()
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return
+	}
+	if err := os.WriteFile(lockPath, nil, 0600); err != nil {
+		return
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return
+	}
+	cmd := exec.Command(exe, "__complete", "--refresh")
+	if err := cmd.Start(); err != nil {
+		os.Remove(lockPath)
+		return
+	}
+	_ = cmd.Process.Release()
+}
+
+// Refresh fetches the completion metadata from the API and rewrites the
+// cache. It is run in the background by Complete.
+func Refresh() error {
+	if lockPath, err := getPath(lockFileName); err == nil {
+		defer os.Remove(lockPath)
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetProjects()
+	if err != nil {
+		return err
+	}
+	projects, err := backlog.ParseProjects(data)
+	if err != nil {
+		return err
+	}
+
+	cache := Cache{
+		Space:     client.GetSpace(),
+		UpdatedAt: time.Now(),
+		Projects:  make([]Project, 0, len(projects)),
+	}
+	for _, project := range projects {
+		cache.Projects = append(cache.Projects, Project{Key: project.ProjectKey, Name: project.Name})
+	}
+
+	path, err := getPath(cacheFileName)
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write through a temp file so a concurrent reader never sees half a file
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, out, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
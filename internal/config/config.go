@@ -12,6 +12,69 @@ type Config struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 	ExpiresAt    int64  `json:"expires_at"`
+
+	// APIKey is used instead of OAuth tokens when set by
+	// 'bgl auth login --with-api-key'.
+	APIKey string `json:"api_key,omitempty"`
+
+	// Preferences set by 'bgl init'.
+	DefaultProject string `json:"default_project,omitempty"`
+	Editor         string `json:"editor,omitempty"`
+	Pager          string `json:"pager,omitempty"`
+
+	// Next holds the ranking weights for 'bgl next'.
+	Next *NextWeights `json:"next,omitempty"`
+
+	// Secrets configures scanning of outgoing text for secrets.
+	Secrets *SecretsConfig `json:"secrets,omitempty"`
+
+	// UsageStats enables local usage counters ('bgl stats --self').
+	UsageStats bool `json:"usage_stats,omitempty"`
+
+	// RequestLog enables the request log included by 'bgl bugreport'.
+	RequestLog bool `json:"request_log,omitempty"`
+
+	// Locale configures how dates and numbers are displayed.
+	Locale *LocaleConfig `json:"locale,omitempty"`
+
+	// Onboarding configures 'bgl project onboard'.
+	Onboarding *OnboardingConfig `json:"onboarding,omitempty"`
+
+	// GitProtocol is the clone URL used by 'bgl repo view --clone': "ssh"
+	// (default) or "https".
+	GitProtocol string `json:"git_protocol,omitempty"`
+}
+
+// OnboardingConfig configures 'bgl project onboard'. TemplateIssue is the
+// key of the issue copied for new members, per project key. Welcome is the
+// welcome comment; "{name}" is replaced with the new member's name.
+type OnboardingConfig struct {
+	TemplateIssue map[string]string `json:"template_issue,omitempty"`
+	Welcome       string            `json:"welcome,omitempty"`
+}
+
+// LocaleConfig configures display formatting. DateFormat is one of
+// yyyy-MM-dd (default), yyyy/MM/dd, dd.MM.yyyy, dd/MM/yyyy, or MM/dd/yyyy.
+// WeekStart is "monday" (default) or "sunday".
+type LocaleConfig struct {
+	DateFormat         string `json:"date_format,omitempty"`
+	ThousandsSeparator string `json:"thousands_separator,omitempty"`
+	WeekStart          string `json:"week_start,omitempty"`
+}
+
+// SecretsConfig configures secret scanning. Mode is "block" (default),
+// "warn", or "off". Matches containing an Allowlist entry are ignored.
+type SecretsConfig struct {
+	Mode      string   `json:"mode"`
+	Allowlist []string `json:"allowlist"`
+}
+
+// NextWeights holds the ranking weights for 'bgl next'. A zero weight
+// disables that factor.
+type NextWeights struct {
+	Due       float64 `json:"due"`
+	Priority  float64 `json:"priority"`
+	Staleness float64 `json:"staleness"`
 }
 
 // configFileName is the name of the config file.
@@ -31,6 +94,20 @@ func GetConfigDir() (string, error) {
 	return filepath.Join(homeDir, ".config", "bgl"), nil
 }
 
+// GetStateDir returns the state directory path.
+// If XDG_STATE_HOME is set, it uses $XDG_STATE_HOME/bgl.
+// Otherwise, it falls back to $HOME/.local/state/bgl.
+func GetStateDir() (string, error) {
+	if xdgStateHome := os.Getenv("XDG_STATE_HOME"); xdgStateHome != "" {
+		return filepath.Join(xdgStateHome, "bgl"), nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".local", "state", "bgl"), nil
+}
+
 // GetConfigPath returns the full path to the config file.
 func GetConfigPath() (string, error) {
 	configDir, err := GetConfigDir()
@@ -63,6 +140,16 @@ func Load() (*Config, error) {
 	return &cfg, nil
 }
 
+// Exists reports whether the config file exists.
+func Exists() bool {
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(configPath)
+	return err == nil
+}
+
 // Save writes the configuration to config.json.
 func (c *Config) Save() error {
 	configDir, err := GetConfigDir()
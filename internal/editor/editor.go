@@ -0,0 +1,113 @@
+package editor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/config"
+)
+
+// scissors separates the message from the commented-out help in an editor
+// template. Everything from this line on is discarded, so Markdown headings
+// starting with "#" above it are kept.
+const scissors = "# ------------------------ >8 ------------------------"
+
+// Configured reports whether an editor is set explicitly, either by
+// 'bgl init' or through $VISUAL or $EDITOR.
+func Configured() bool {
+	if cfg, err := config.Load(); err == nil && strings.TrimSpace(cfg.Editor) != "" {
+		return true
+	}
+	return strings.TrimSpace(os.Getenv("VISUAL")) != "" || strings.TrimSpace(os.Getenv("EDITOR")) != ""
+}
+
+// IsTerminal reports whether stdin is an interactive terminal.
+func IsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// command returns the editor command split into its fields: the configured
+// editor, then $VISUAL, then $EDITOR, falling back to vi. Blank settings are
+// skipped.
+func command() []string {
+	var candidates []string
+	if cfg, err := config.Load(); err == nil {
+		candidates = append(candidates, cfg.Editor)
+	}
+	candidates = append(candidates, os.Getenv("VISUAL"), os.Getenv("EDITOR"))
+	for _, candidate := range candidates {
+		// The editor command may include arguments (e.g. "code --wait")
+		if parts := strings.Fields(candidate); len(parts) > 0 {
+			return parts
+		}
+	}
+	return []string{"vi"}
+}
+
+// Edit opens the editor on a temporary file containing initial and returns
+// the edited content.
+func Edit(initial string) (string, error) {
+	f, err := os.CreateTemp("", "bgl-*.md")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	path := f.Name()
+	defer os.Remove(path)
+
+	if _, err := f.WriteString(initial); err != nil {
+		f.Close()
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	parts := command()
+	cmd := exec.Command(parts[0], append(parts[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("editor failed: %w", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read temp file: %w", err)
+	}
+	return string(data), nil
+}
+
+// EditWithHelp opens the editor with help lines below a scissors line, like
+// git commit, and returns the text above it with trailing blank lines removed.
+func EditWithHelp(initial string, help []string) (string, error) {
+	var sb strings.Builder
+	sb.WriteString(initial)
+	sb.WriteString("\n\n")
+	sb.WriteString(scissors + "\n")
+	sb.WriteString("# Do not modify or remove the line above.\n")
+	sb.WriteString("# Everything below it will be ignored.\n")
+	for _, line := range help {
+		if line == "" {
+			sb.WriteString("#\n")
+			continue
+		}
+		sb.WriteString("# " + line + "\n")
+	}
+
+	content, err := Edit(sb.String())
+	if err != nil {
+		return "", err
+	}
+
+	if i := strings.Index(content, scissors); i >= 0 {
+		content = content[:i]
+	}
+	return strings.TrimRight(content, " \t\n"), nil
+}
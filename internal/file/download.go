@@ -0,0 +1,71 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/locale"
+)
+
+// DownloadOptions contains options for the download command.
+type DownloadOptions struct {
+	Dir string
+}
+
+// Download downloads a shared file, given by its path in the project's file
+// storage, into the current directory or Dir.
+func Download(projectIDOrKey string, filePath string, opts DownloadOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityFileSharing); err != nil {
+		return err
+	}
+
+	// Files are downloaded by ID, so look the file up in its directory
+	dir, name := path.Split(path.Clean("/" + filePath))
+	data, err := fetchFiles(client, projectIDOrKey, dir)
+	if err != nil {
+		return err
+	}
+	files, err := backlog.ParseSharedFiles(data)
+	if err != nil {
+		return err
+	}
+	var target *backlog.SharedFile
+	for i, file := range files {
+		if file.Name == name {
+			target = &files[i]
+			break
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("file not found: %s", dir+name)
+	}
+	if target.Type == "directory" {
+		return fmt.Errorf("%s is a directory", dir+name)
+	}
+
+	content, _, err := client.DownloadSharedFile(projectIDOrKey, target.ID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Dir != "" {
+		if err := os.MkdirAll(opts.Dir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+	}
+	out := filepath.Join(opts.Dir, filepath.Base(target.Name))
+	if err := os.WriteFile(out, content, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Printf("Downloaded: %s (%s bytes)\n", out, locale.Number(int64(len(content))))
+	return nil
+}
@@ -0,0 +1,77 @@
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw bool
+}
+
+// maxFileCount is the largest page the shared files API returns.
+const maxFileCount = 1000
+
+// List displays the files and directories in a directory of a project's
+// shared file storage.
+func List(projectIDOrKey string, dir string, opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityFileSharing); err != nil {
+		return err
+	}
+
+	data, err := fetchFiles(client, projectIDOrKey, dir)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	files, err := backlog.ParseSharedFiles(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatSharedFilesMarkdown(dir, files)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// fetchFiles fetches all entries of a directory, paging with offset.
+func fetchFiles(client *backlog.Client, projectIDOrKey string, dir string) ([]byte, error) {
+	query := url.Values{}
+	query.Set("count", strconv.Itoa(maxFileCount))
+
+	all := []json.RawMessage{}
+	for {
+		query.Set("offset", strconv.Itoa(len(all)))
+		data, err := client.GetSharedFiles(projectIDOrKey, dir, query)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []json.RawMessage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse shared files: %w", err)
+		}
+		all = append(all, page...)
+		if len(page) < maxFileCount {
+			break
+		}
+	}
+	return json.Marshal(all)
+}
@@ -0,0 +1,123 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// run runs git with args and returns its trimmed standard output. The
+// error includes git's standard error.
+func run(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// CurrentBranch returns the name of the branch checked out in the current
+// directory's repository.
+func CurrentBranch() (string, error) {
+	branch, err := run("symbolic-ref", "--short", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("not on a branch (run inside a git repository): %w", err)
+	}
+	return branch, nil
+}
+
+// Remote is a git remote pointing at a Backlog Git repository.
+type Remote struct {
+	Name       string
+	URL        string
+	ProjectKey string
+	Repo       string
+}
+
+// BacklogRemote finds the remote of the current directory's repository
+// that points at a Backlog Git repository, preferring origin.
+func BacklogRemote() (*Remote, error) {
+	out, err := run("remote")
+	if err != nil {
+		return nil, fmt.Errorf("not in a git repository: %w", err)
+	}
+
+	names := strings.Fields(out)
+	for i, name := range names {
+		if name == "origin" {
+			names[0], names[i] = names[i], names[0]
+		}
+	}
+
+	for _, name := range names {
+		remoteURL, err := run("remote", "get-url", name)
+		if err != nil {
+			continue
+		}
+		if projectKey, repo, ok := ParseBacklogURL(remoteURL); ok {
+			return &Remote{Name: name, URL: remoteURL, ProjectKey: projectKey, Repo: repo}, nil
+		}
+	}
+	return nil, fmt.Errorf("no remote points at a Backlog Git repository")
+}
+
+// ParseBacklogURL extracts the project key and repository name from a
+// Backlog Git clone URL, either HTTPS
+// (https://space.backlog.com/git/PROJ/repo.git) or SSH
+// (space@space.git.backlog.com:/PROJ/repo.git).
+func ParseBacklogURL(remoteURL string) (projectKey string, repo string, ok bool) {
+	var host, path string
+	if rest, found := strings.CutPrefix(remoteURL, "https://"); found {
+		host, path, _ = strings.Cut(rest, "/")
+		path, found = strings.CutPrefix(path, "git/")
+		if !found {
+			return "", "", false
+		}
+	} else if _, rest, found := strings.Cut(remoteURL, "@"); found {
+		host, path, _ = strings.Cut(rest, ":")
+		path = strings.TrimPrefix(path, "/")
+	} else {
+		return "", "", false
+	}
+
+	// Strip credentials from HTTPS URLs
+	if _, after, found := strings.Cut(host, "@"); found {
+		host = after
+	}
+	if !strings.Contains(host, ".backlog.") && !strings.Contains(host, ".backlogtool.") {
+		return "", "", false
+	}
+
+	parts := strings.Split(strings.TrimSuffix(path, ".git"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// BranchExists reports whether a local branch exists.
+func BranchExists(branch string) bool {
+	_, err := run("rev-parse", "--verify", "--quiet", "refs/heads/"+branch)
+	return err == nil
+}
+
+// Interactive runs git with args, connected to the terminal so its
+// progress and errors are shown.
+func Interactive(args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git %s failed: %w", args[0], err)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package htmlmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// blockStartPattern matches a document starting with a block-level tag, as
+// rich text pasted from mail clients and wikis does.
+var blockStartPattern = regexp.MustCompile(`(?i)^(<!doctype html>|<meta[^>]*>|\s)*<(p|div|ul|ol|h[1-6]|table|pre|blockquote|html|body)(\s[^>]*)?>`)
+
+// markdownLinePattern matches lines with Markdown block structure: headings,
+// list items, quotes, and code fences.
+var markdownLinePattern = regexp.MustCompile("(?m)^\\s*(#{1,6}\\s|[-*+]\\s|\\d+\\.\\s|>|```)")
+
+var (
+	trailingSpacePattern = regexp.MustCompile(` +\n`)
+	blankLinesPattern    = regexp.MustCompile(`\n{3,}`)
+)
+
+// IsHTML reports whether s is mainly HTML rather than plain text or
+// Markdown: it starts with a block-level tag and has no Markdown block
+// structure. Markdown that merely mentions a tag such as <div> is not HTML.
+func IsHTML(s string) bool {
+	s = strings.TrimSpace(s)
+	return blockStartPattern.MatchString(s) && !markdownLinePattern.MatchString(s)
+}
+
+// Convert converts HTML to Markdown.
+func Convert(s string) (string, error) {
+	doc, err := html.Parse(strings.NewReader(s))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	c := &converter{}
+	c.walk(doc)
+
+	// Drop trailing spaces and collapse runs of blank lines left by nested blocks
+	out := trailingSpacePattern.ReplaceAllString(c.sb.String(), "\n")
+	out = blankLinesPattern.ReplaceAllString(out, "\n\n")
+	return strings.TrimSpace(out), nil
+}
+
+type converter struct {
+	sb    strings.Builder
+	lists []listState
+	pre   bool
+}
+
+type listState struct {
+	ordered bool
+	index   int
+}
+
+func (c *converter) walk(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		c.text(n.Data)
+		return
+	case html.ElementNode:
+		c.element(n)
+		return
+	}
+	c.children(n)
+}
+
+func (c *converter) children(n *html.Node) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.walk(child)
+	}
+}
+
+func (c *converter) text(s string) {
+	if c.pre {
+		c.sb.WriteString(s)
+		return
+	}
+	collapsed := strings.Join(strings.Fields(s), " ")
+	// Keep a single space where the source had whitespace around the text
+	if s != "" && isSpace(s[0]) && !c.atSpace() {
+		c.sb.WriteString(" ")
+	}
+	if collapsed == "" {
+		return
+	}
+	c.sb.WriteString(collapsed)
+	if isSpace(s[len(s)-1]) {
+		c.sb.WriteString(" ")
+	}
+}
+
+// atSpace reports whether the output is empty or ends with whitespace.
+func (c *converter) atSpace() bool {
+	out := c.sb.String()
+	return out == "" || isSpace(out[len(out)-1])
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r'
+}
+
+func (c *converter) block() {
+	c.sb.WriteString("\n\n")
+}
+
+func (c *converter) element(n *html.Node) {
+	switch n.Data {
+	case "script", "style", "head":
+		return
+	case "br":
+		c.sb.WriteString("\n")
+	case "p", "div":
+		c.block()
+		c.children(n)
+		c.block()
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		c.block()
+		c.sb.WriteString(strings.Repeat("#", int(n.Data[1]-'0')) + " ")
+		c.children(n)
+		c.block()
+	case "b", "strong":
+		c.wrap(n, "**")
+	case "i", "em":
+		c.wrap(n, "*")
+	case "code":
+		if c.pre {
+			c.children(n)
+		} else {
+			c.wrap(n, "`")
+		}
+	case "pre":
+		c.block()
+		c.sb.WriteString("```\n")
+		c.pre = true
+		c.children(n)
+		c.pre = false
+		c.sb.WriteString("\n```")
+		c.block()
+	case "blockquote":
+		var inner converter
+		inner.children(n)
+		c.block()
+		for line := range strings.SplitSeq(strings.TrimSpace(inner.sb.String()), "\n") {
+			c.sb.WriteString("> " + line + "\n")
+		}
+		c.block()
+	case "a":
+		href := attr(n, "href")
+		if href == "" {
+			c.children(n)
+			return
+		}
+		c.sb.WriteString("[")
+		c.children(n)
+		fmt.Fprintf(&c.sb, "](%s)", href)
+	case "img":
+		fmt.Fprintf(&c.sb, "![%s](%s)", attr(n, "alt"), attr(n, "src"))
+	case "ul", "ol":
+		if len(c.lists) == 0 {
+			c.block()
+		}
+		c.lists = append(c.lists, listState{ordered: n.Data == "ol"})
+		c.children(n)
+		c.lists = c.lists[:len(c.lists)-1]
+		if len(c.lists) == 0 {
+			c.block()
+		}
+	case "li":
+		c.sb.WriteString("\n" + strings.Repeat("  ", max(len(c.lists)-1, 0)))
+		if len(c.lists) > 0 && c.lists[len(c.lists)-1].ordered {
+			c.lists[len(c.lists)-1].index++
+			fmt.Fprintf(&c.sb, "%d. ", c.lists[len(c.lists)-1].index)
+		} else {
+			c.sb.WriteString("- ")
+		}
+		c.children(n)
+	case "tr":
+		c.sb.WriteString("\n|")
+		c.children(n)
+	case "td", "th":
+		c.sb.WriteString(" ")
+		c.children(n)
+		c.sb.WriteString(" |")
+	default:
+		c.children(n)
+	}
+}
+
+func (c *converter) wrap(n *html.Node, marker string) {
+	c.sb.WriteString(marker)
+	c.children(n)
+	c.sb.WriteString(marker)
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,23 @@
+package htmlmd
+
+import "testing"
+
+func TestIsHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want bool
+	}{
+		{"pasted rich text", "<div><p>Hello <b>world</b></p></div>", true},
+		{"mail client paste", "<meta charset=\"utf-8\"><div>Hi</div>", true},
+		{"inline tag in Markdown", "Wrap it in a `<div>` and make the title <b>bold</b>.", false},
+		{"Markdown list with tags", "<p>Steps:</p>\n- add a `<div>`\n- restart", false},
+		{"Markdown with code fence", "## Fix\n\n```html\n<div>x</div>\n```", false},
+		{"plain text", "Looks good to me", false},
+	}
+	for _, tt := range tests {
+		if got := IsHTML(tt.in); got != tt.want {
+			t.Errorf("%s: IsHTML(%q) = %v, want %v", tt.name, tt.in, got, tt.want)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package issue
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -9,6 +8,9 @@ import (
 
 	"github.com/charmbracelet/huh"
 	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
 )
 
 // AddOptions contains options for the add command.
@@ -117,17 +119,19 @@ func Add(opts AddOptions) error {
 		}
 	}
 
+	if err := secrets.Check(summary, opts.Description); err != nil {
+		return err
+	}
+
 	// Show confirmation unless --yes is specified
 	if !opts.Yes {
-		var confirm bool
-		if err := huh.NewConfirm().
-			Title("Create Issue?").
-			Description(fmt.Sprintf("Project: %s\nSummary: %s", project.ProjectKey, summary)).
-			Affirmative("Confirm").
-			Negative("Cancel").
-			Value(&confirm).
-			Run(); err != nil {
-			return fmt.Errorf("confirmation failed: %w", err)
+		confirm, err := prompt.Confirm("Create Issue?", "Confirm", []string{
+			"Space: " + client.GetSpace(),
+			"Project: " + project.ProjectKey,
+			"Summary: " + summary,
+		})
+		if err != nil {
+			return err
 		}
 
 		if !confirm {
@@ -166,18 +170,7 @@ func Add(opts AddOptions) error {
 	}
 
 	if opts.Raw {
-		// Pretty print JSON
-		var prettyJSON map[string]any
-		if err := json.Unmarshal(result, &prettyJSON); err != nil {
-			fmt.Println(string(result))
-			return nil
-		}
-		formatted, err := json.MarshalIndent(prettyJSON, "", "  ")
-		if err != nil {
-			fmt.Println(string(result))
-			return nil
-		}
-		fmt.Println(string(formatted))
+		render.JSON(result)
 		return nil
 	}
 
@@ -0,0 +1,202 @@
+package issue
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/locale"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/textdiff"
+)
+
+// HistoryOptions contains options for the history command.
+type HistoryOptions struct {
+	Raw   bool
+	Field string
+	Diff  bool
+}
+
+// historyEntry is a single field change of an issue.
+type historyEntry struct {
+	CommentID     int    `json:"commentId"`
+	Created       string `json:"created"`
+	User          string `json:"user"`
+	Field         string `json:"field"`
+	OriginalValue string `json:"originalValue"`
+	NewValue      string `json:"newValue"`
+}
+
+// ANSI colors for diff output.
+const (
+	colorReset = "\x1b[0m"
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorCyan  = "\x1b[36m"
+	colorBold  = "\x1b[1m"
+)
+
+// History displays the change log of an issue, oldest first. With Diff,
+// each change of Field is shown as a unified diff between the recorded
+// original and new values.
+func History(issueKeyOrID string, opts HistoryOptions) error {
+	if opts.Diff && opts.Field == "" {
+		return fmt.Errorf("--diff requires --field")
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	entries, err := fetchHistory(client, issueKeyOrID, opts.Field)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		formatted, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+		return nil
+	}
+
+	if opts.Diff {
+		printHistoryDiff(issueKeyOrID, entries, render.Enabled())
+		return nil
+	}
+
+	render.Markdown(formatHistoryMarkdown(issueKeyOrID, entries))
+	return nil
+}
+
+// fetchHistory pages through all comments of an issue in ascending order
+// and collects their change log entries, keeping only field if set.
+func fetchHistory(client *backlog.Client, issueKeyOrID string, field string) ([]historyEntry, error) {
+	query := url.Values{}
+	query.Set("count", "100")
+	query.Set("order", "asc")
+
+	entries := []historyEntry{}
+	seen := map[int]bool{}
+	for {
+		data, err := client.GetComments(issueKeyOrID, query)
+		if err != nil {
+			return nil, err
+		}
+		comments, err := backlog.ParseComments(data)
+		if err != nil {
+			return nil, err
+		}
+
+		fetched := 0
+		for _, comment := range comments {
+			if seen[comment.ID] {
+				continue
+			}
+			seen[comment.ID] = true
+			fetched++
+
+			user := "(unknown)"
+			if comment.CreatedUser != nil {
+				user = comment.CreatedUser.Name
+			}
+			for _, change := range comment.ChangeLog {
+				if field != "" && !strings.EqualFold(change.Field, field) {
+					continue
+				}
+				entries = append(entries, historyEntry{
+					CommentID:     comment.ID,
+					Created:       comment.Created,
+					User:          user,
+					Field:         change.Field,
+					OriginalValue: change.OriginalValue,
+					NewValue:      change.NewValue,
+				})
+			}
+		}
+		if fetched == 0 {
+			break
+		}
+		query.Set("minId", strconv.Itoa(comments[len(comments)-1].ID))
+	}
+
+	return entries, nil
+}
+
+// formatHistoryMarkdown formats the change log as a Markdown timeline.
+func formatHistoryMarkdown(issueKeyOrID string, entries []historyEntry) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "## History of %s\n", issueKeyOrID)
+	if len(entries) == 0 {
+		sb.WriteString("\nNo changes recorded.\n")
+		return sb.String()
+	}
+	for _, entry := range entries {
+		fmt.Fprintf(&sb, "- %s %s: **%s** %s → %s\n", locale.DateTimeString(entry.Created), entry.User, entry.Field,
+			historyValue(entry.OriginalValue), historyValue(entry.NewValue))
+	}
+
+	return sb.String()
+}
+
+// historyValue shortens a value for the timeline; multi-line values such as
+// descriptions are summarized by line count.
+func historyValue(s string) string {
+	if s == "" {
+		return "(empty)"
+	}
+	if n := strings.Count(s, "\n"); n > 0 {
+		return fmt.Sprintf("(%d lines)", n+1)
+	}
+	return s
+}
+
+// printHistoryDiff prints each change as a unified diff, colored if color
+// is set. A gap is reported when a change's original value differs from
+// the previous change's new value, since the versions in between are not
+// recorded.
+func printHistoryDiff(issueKeyOrID string, entries []historyEntry, color bool) {
+	paint := func(code string, s string) string {
+		if !color {
+			return s
+		}
+		return code + s + colorReset
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("No changes recorded for %s.\n", issueKeyOrID)
+		return
+	}
+
+	for i, entry := range entries {
+		if i > 0 {
+			fmt.Println()
+			if entries[i-1].NewValue != entry.OriginalValue {
+				fmt.Println(paint(colorCyan, "# (unrecorded changes before this version)"))
+			}
+		}
+		fmt.Println(paint(colorBold, fmt.Sprintf("%s  %s  (comment %d)", locale.DateTimeString(entry.Created), entry.User, entry.CommentID)))
+		fmt.Println(paint(colorBold, "--- a/"+entry.Field))
+		fmt.Println(paint(colorBold, "+++ b/"+entry.Field))
+		for _, hunk := range textdiff.Unified(entry.OriginalValue, entry.NewValue, 3) {
+			fmt.Println(paint(colorCyan, hunk.Header()))
+			for _, line := range hunk.Lines {
+				text := string(line.Kind) + line.Text
+				switch line.Kind {
+				case textdiff.Delete:
+					text = paint(colorRed, text)
+				case textdiff.Insert:
+					text = paint(colorGreen, text)
+				}
+				fmt.Println(text)
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package issue
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw            bool
+	TSV            bool
+	Format         string
+	ProjectIDOrKey string
+	Count          int
+}
+
+// List displays the issue list of a project.
+func List(opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	if opts.ProjectIDOrKey != "" {
+		// The issue list API only accepts numeric project IDs.
+		projectData, err := client.GetProject(opts.ProjectIDOrKey)
+		if err != nil {
+			return err
+		}
+		project, err := backlog.ParseProject(projectData)
+		if err != nil {
+			return err
+		}
+		query.Set("projectId[]", strconv.Itoa(project.ID))
+	}
+	query.Set("sort", "updated")
+
+	if opts.Format == "jsonl" {
+		return listJSONL(client, query, opts.Count)
+	}
+
+	if opts.Count > 0 {
+		query.Set("count", strconv.Itoa(opts.Count))
+	}
+
+	data, err := client.GetIssues(query)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	issues, err := backlog.ParseIssues(data)
+	if err != nil {
+		return err
+	}
+
+	if opts.TSV {
+		fmt.Print(backlog.FormatIssuesTSV(issues))
+		return nil
+	}
+
+	if len(issues) == 0 {
+		fmt.Println("No issues found.")
+		return nil
+	}
+
+	markdown := backlog.FormatIssuesMarkdown(issues)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// issuePageSize is the largest count the issue list API accepts.
+const issuePageSize = 100
+
+// listJSONL prints issues as JSON Lines, following pages with offset until
+// limit issues (all of them when limit is 0) are printed. Each page is
+// written before the next is fetched, so large exports stream.
+func listJSONL(client *backlog.Client, query url.Values, limit int) error {
+	for offset := 0; limit == 0 || offset < limit; {
+		count := issuePageSize
+		if limit > 0 && limit-offset < count {
+			count = limit - offset
+		}
+		query.Set("count", strconv.Itoa(count))
+		query.Set("offset", strconv.Itoa(offset))
+
+		data, err := client.GetIssues(query)
+		if err != nil {
+			return err
+		}
+		var page []json.RawMessage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return fmt.Errorf("failed to parse issues: %w", err)
+		}
+		lines, err := backlog.FormatJSONL(data)
+		if err != nil {
+			return err
+		}
+		fmt.Print(lines)
+
+		if len(page) < count {
+			break
+		}
+		offset += len(page)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package issue
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// PullRequestsOptions contains options for the prs command.
+type PullRequestsOptions struct {
+	Raw bool
+}
+
+// PullRequests displays the Git pull requests linked to an issue across
+// all repositories of the issue's project.
+func PullRequests(issueKeyOrID string, opts PullRequestsOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	data, err := client.GetIssue(issueKeyOrID)
+	if err != nil {
+		return err
+	}
+	issue, err := backlog.ParseIssue(data)
+	if err != nil {
+		return err
+	}
+
+	projectID := strconv.Itoa(issue.ProjectId)
+	data, err = client.GetGitRepositories(projectID)
+	if err != nil {
+		return err
+	}
+	repositories, err := backlog.ParseRepositories(data)
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	query.Set("issueId[]", strconv.Itoa(issue.ID))
+
+	var sb strings.Builder
+	var rawPullRequests []any
+	found := 0
+
+	for _, repository := range repositories {
+		data, err := client.GetPullRequests(projectID, strconv.Itoa(repository.ID), query)
+		if err != nil {
+			return err
+		}
+
+		if opts.Raw {
+			var items []any
+			if err := json.Unmarshal(data, &items); err != nil {
+				return fmt.Errorf("failed to parse pull requests: %w", err)
+			}
+			rawPullRequests = append(rawPullRequests, items...)
+			continue
+		}
+
+		pullRequests, err := backlog.ParsePullRequests(data)
+		if err != nil {
+			return err
+		}
+		if len(pullRequests) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "## %s\n", repository.Name)
+		for _, pr := range pullRequests {
+			sb.WriteString(backlog.FormatPullRequestMarkdownLine(&pr))
+		}
+		sb.WriteString("\n")
+		found += len(pullRequests)
+	}
+
+	if opts.Raw {
+		if rawPullRequests == nil {
+			rawPullRequests = []any{}
+		}
+		formatted, err := json.MarshalIndent(rawPullRequests, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+		return nil
+	}
+
+	if found == 0 {
+		fmt.Println("No pull requests found.")
+		return nil
+	}
+
+	markdown := sb.String()
+
+	render.Markdown(markdown)
+	return nil
+}
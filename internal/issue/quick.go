@@ -0,0 +1,267 @@
+package issue
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+)
+
+// QuickOptions contains options for the quick command.
+type QuickOptions struct {
+	Raw            bool
+	Yes            bool
+	ProjectIDOrKey string
+}
+
+// quickProjectPattern matches a leading project key such as "PROJ:".
+var quickProjectPattern = regexp.MustCompile(`^([A-Z][A-Z0-9_]*):\s*`)
+
+// quickLine holds the tokens parsed from a quick-create line.
+type quickLine struct {
+	Project    string
+	Summary    string
+	Priority   string
+	Assignee   string
+	Due        string
+	Categories []string
+}
+
+// parseQuickLine splits a line like
+// "PROJ: Fix login timeout !high @kim due:fri #backend" into its tokens.
+// Words that are not tokens form the summary.
+func parseQuickLine(line string) quickLine {
+	var parsed quickLine
+
+	line = strings.TrimSpace(line)
+	if m := quickProjectPattern.FindStringSubmatch(line); m != nil {
+		parsed.Project = m[1]
+		line = line[len(m[0]):]
+	}
+
+	var words []string
+	for _, word := range strings.Fields(line) {
+		switch {
+		case len(word) > 1 && strings.HasPrefix(word, "!"):
+			parsed.Priority = word[1:]
+		case len(word) > 1 && strings.HasPrefix(word, "@"):
+			parsed.Assignee = word[1:]
+		case len(word) > 1 && strings.HasPrefix(word, "#"):
+			parsed.Categories = append(parsed.Categories, word[1:])
+		case len(word) > 4 && strings.HasPrefix(strings.ToLower(word), "due:"):
+			parsed.Due = word[4:]
+		default:
+			words = append(words, word)
+		}
+	}
+	parsed.Summary = strings.Join(words, " ")
+
+	return parsed
+}
+
+// parseDueDate parses a due date given as yyyy-MM-dd, today, tomorrow,
+// +<n>d, or a weekday name (the next such day after today).
+func parseDueDate(s string, now time.Time) (string, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	value := strings.ToLower(s)
+
+	switch value {
+	case "today":
+		return today.Format("2006-01-02"), nil
+	case "tomorrow", "tmr":
+		return today.AddDate(0, 0, 1).Format("2006-01-02"), nil
+	}
+
+	if strings.HasPrefix(value, "+") && strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(value[1 : len(value)-1])
+		if err == nil && days >= 0 {
+			return today.AddDate(0, 0, days).Format("2006-01-02"), nil
+		}
+	}
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		name := strings.ToLower(weekday.String())
+		if len(value) >= 3 && strings.HasPrefix(name, value) {
+			days := (int(weekday) - int(today.Weekday()) + 7) % 7
+			if days == 0 {
+				days = 7
+			}
+			return today.AddDate(0, 0, days).Format("2006-01-02"), nil
+		}
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", s, now.Location()); err == nil {
+		return t.Format("2006-01-02"), nil
+	}
+
+	return "", fmt.Errorf("invalid due date: %s (use yyyy-MM-dd, today, tomorrow, +<n>d, or a weekday)", s)
+}
+
+// Quick creates an issue from a single line, echoing the parsed fields
+// before creating it. The issue type defaults to the project's first.
+func Quick(line string, opts QuickOptions) error {
+	parsed := parseQuickLine(line)
+	if parsed.Project == "" {
+		parsed.Project = opts.ProjectIDOrKey
+	}
+	if parsed.Project == "" {
+		return fmt.Errorf("project is required (prefix the line with \"PROJ:\" or set a default project)")
+	}
+	if parsed.Summary == "" {
+		return fmt.Errorf("summary cannot be empty")
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	addOpts := AddOptions{
+		Raw:            opts.Raw,
+		Yes:            true,
+		ProjectIDOrKey: parsed.Project,
+		Summary:        parsed.Summary,
+	}
+
+	// Issue type: the first one in display order
+	data, err := client.GetIssueTypes(parsed.Project)
+	if err != nil {
+		return err
+	}
+	issueTypes, err := backlog.ParseIssueTypes(data)
+	if err != nil {
+		return err
+	}
+	if len(issueTypes) == 0 {
+		return fmt.Errorf("no issue types found in project %s", parsed.Project)
+	}
+	issueType := issueTypes[0]
+	for _, t := range issueTypes[1:] {
+		if t.DisplayOrder < issueType.DisplayOrder {
+			issueType = t
+		}
+	}
+	addOpts.IssueTypeID = strconv.Itoa(issueType.ID)
+
+	// Priority: matched by name, or the middle one (Normal) if omitted
+	data, err = client.GetPriorities()
+	if err != nil {
+		return err
+	}
+	priorities, err := backlog.ParsePriorities(data)
+	if err != nil {
+		return err
+	}
+	if len(priorities) == 0 {
+		return fmt.Errorf("no priorities found")
+	}
+	priority := priorities[len(priorities)/2]
+	if parsed.Priority != "" {
+		found := false
+		for _, p := range priorities {
+			if strings.EqualFold(p.Name, parsed.Priority) || strconv.Itoa(p.ID) == parsed.Priority {
+				priority = p
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("priority not found: %s", parsed.Priority)
+		}
+	}
+	addOpts.PriorityID = strconv.Itoa(priority.ID)
+
+	assignee := ""
+	if parsed.Assignee != "" {
+		data, err := client.GetProjectUsers(parsed.Project)
+		if err != nil {
+			return err
+		}
+		users, err := backlog.ParseUsers(data)
+		if err != nil {
+			return err
+		}
+		user, err := backlog.FindUser(users, parsed.Assignee)
+		if err != nil {
+			return err
+		}
+		addOpts.AssigneeID = strconv.Itoa(user.ID)
+		assignee = user.Name
+	}
+
+	if parsed.Due != "" {
+		addOpts.DueDate, err = parseDueDate(parsed.Due, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	var categoryNames []string
+	if len(parsed.Categories) > 0 {
+		data, err := client.GetCategories(parsed.Project)
+		if err != nil {
+			return err
+		}
+		categories, err := backlog.ParseCategories(data)
+		if err != nil {
+			return err
+		}
+		var ids []string
+		for _, name := range parsed.Categories {
+			found := false
+			for _, category := range categories {
+				if strings.EqualFold(category.Name, name) {
+					ids = append(ids, strconv.Itoa(category.ID))
+					categoryNames = append(categoryNames, category.Name)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("category not found: %s", name)
+			}
+		}
+		addOpts.CategoryIDs = strings.Join(ids, ",")
+	}
+
+	// Echo the parsed fields so mistakes are caught before creating
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Space: %s\n", client.GetSpace())
+	fmt.Fprintf(&sb, "Project: %s\n", parsed.Project)
+	fmt.Fprintf(&sb, "Summary: %s\n", parsed.Summary)
+	fmt.Fprintf(&sb, "Type: %s\n", issueType.Name)
+	fmt.Fprintf(&sb, "Priority: %s\n", priority.Name)
+	if assignee != "" {
+		fmt.Fprintf(&sb, "Assignee: %s\n", assignee)
+	}
+	if addOpts.DueDate != "" {
+		fmt.Fprintf(&sb, "Due: %s\n", addOpts.DueDate)
+	}
+	if len(categoryNames) > 0 {
+		fmt.Fprintf(&sb, "Category: %s\n", strings.Join(categoryNames, ", "))
+	}
+	summary := strings.TrimRight(sb.String(), "\n")
+
+	if opts.Yes {
+		if !opts.Raw {
+			fmt.Println(summary)
+			fmt.Println()
+		}
+	} else {
+		confirm, err := prompt.Confirm("Create Issue?", "Confirm", []string{summary})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	return Add(addOpts)
+}
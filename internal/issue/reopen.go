@@ -0,0 +1,108 @@
+package issue
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// IDs of Backlog's built-in "Resolved" and "Closed" statuses, the only
+// statuses an issue can be reopened from.
+const (
+	resolvedStatusID = 3
+	closedStatusID   = 4
+)
+
+// ReopenOptions contains options for the reopen command.
+type ReopenOptions struct {
+	Raw     bool
+	Comment string
+	Notify  string
+}
+
+// Reopen moves a resolved or closed issue back to its project's initial
+// status (the status with the lowest display order) and displays the
+// result.
+func Reopen(issueKeyOrID string, opts ReopenOptions) error {
+	if err := secrets.Check(opts.Comment); err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetIssue(issueKeyOrID)
+	if err != nil {
+		return err
+	}
+	current, err := backlog.ParseIssue(data)
+	if err != nil {
+		return err
+	}
+
+	if current.Status == nil || (current.Status.ID != resolvedStatusID && current.Status.ID != closedStatusID) {
+		status := "(unknown)"
+		if current.Status != nil {
+			status = current.Status.Name
+		}
+		return fmt.Errorf("issue %s is %s; only resolved or closed issues can be reopened", issueKeyOrID, status)
+	}
+
+	data, err = client.GetProjectStatuses(strconv.Itoa(current.ProjectId))
+	if err != nil {
+		return err
+	}
+	statuses, err := backlog.ParseProjectStatuses(data)
+	if err != nil {
+		return err
+	}
+	if len(statuses) == 0 {
+		return fmt.Errorf("no statuses found in project %d", current.ProjectId)
+	}
+
+	initial := statuses[0]
+	for _, status := range statuses[1:] {
+		if status.DisplayOrder < initial.DisplayOrder {
+			initial = status
+		}
+	}
+
+	update := url.Values{}
+	update.Set("statusId", strconv.Itoa(initial.ID))
+	if opts.Comment != "" {
+		update.Set("comment", opts.Comment)
+	}
+	if opts.Notify != "" {
+		ids, err := client.ResolveIssueUserIDs(issueKeyOrID, opts.Notify)
+		if err != nil {
+			return err
+		}
+		update["notifiedUserId[]"] = ids
+	}
+
+	result, err := client.UpdateIssue(issueKeyOrID, update)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	issue, err := backlog.ParseIssue(result)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatIssueMarkdown(issue)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -0,0 +1,77 @@
+package issue
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ResolutionOptions contains options for the resolution command.
+type ResolutionOptions struct {
+	Raw    bool
+	Notify string
+}
+
+// SetResolution sets an issue's resolution by name (case-insensitive) or ID
+// and displays the result.
+func SetResolution(issueKeyOrID string, resolution string, opts ResolutionOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetResolutions()
+	if err != nil {
+		return err
+	}
+	resolutions, err := backlog.ParseResolutions(data)
+	if err != nil {
+		return err
+	}
+
+	resolutionID := ""
+	names := make([]string, len(resolutions))
+	for i, r := range resolutions {
+		names[i] = r.Name
+		if strings.EqualFold(r.Name, resolution) || strconv.Itoa(r.ID) == resolution {
+			resolutionID = strconv.Itoa(r.ID)
+		}
+	}
+	if resolutionID == "" {
+		return fmt.Errorf("unknown resolution %q (available: %s)", resolution, strings.Join(names, ", "))
+	}
+
+	update := url.Values{}
+	update.Set("resolutionId", resolutionID)
+	if opts.Notify != "" {
+		ids, err := client.ResolveIssueUserIDs(issueKeyOrID, opts.Notify)
+		if err != nil {
+			return err
+		}
+		update["notifiedUserId[]"] = ids
+	}
+
+	result, err := client.UpdateIssue(issueKeyOrID, update)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	issue, err := backlog.ParseIssue(result)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatIssueMarkdown(issue)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -1,12 +1,12 @@
 package issue
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/url"
 
-	"github.com/charmbracelet/glamour"
 	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
 )
 
 // UpdateOptions contains options for the update command.
@@ -24,10 +24,16 @@ type UpdateOptions struct {
 	MilestoneIDs string
 	VersionIDs   string
 	Comment      string
+	Notify       string
+	Scope        string
 }
 
 // Update updates an issue and displays the result.
 func Update(issueKeyOrID string, opts UpdateOptions) error {
+	if err := secrets.Check(opts.Summary, opts.Description, opts.Comment); err != nil {
+		return err
+	}
+
 	client, err := backlog.NewClient()
 	if err != nil {
 		return err
@@ -69,25 +75,24 @@ func Update(issueKeyOrID string, opts UpdateOptions) error {
 		return fmt.Errorf("no update options specified")
 	}
 
+	notified, err := client.ResolveNotifiedUsers(issueKeyOrID, opts.Scope, opts.Notify)
+	if err != nil {
+		return err
+	}
+	if len(notified) > 0 {
+		data["notifiedUserId[]"] = backlog.UserIDs(notified)
+	}
+	if !opts.Raw {
+		fmt.Printf("Notifying: %s\n\n", backlog.DescribeNotified(opts.Scope, opts.Notify, notified))
+	}
+
 	result, err := client.UpdateIssue(issueKeyOrID, data)
 	if err != nil {
 		return err
 	}
 
 	if opts.Raw {
-		// Pretty print JSON
-		var prettyJSON map[string]any
-		if err := json.Unmarshal(result, &prettyJSON); err != nil {
-			// If pretty print fails, output raw
-			fmt.Println(string(result))
-			return nil
-		}
-		formatted, err := json.MarshalIndent(prettyJSON, "", "  ")
-		if err != nil {
-			fmt.Println(string(result))
-			return nil
-		}
-		fmt.Println(string(formatted))
+		render.JSON(result)
 		return nil
 	}
 
@@ -98,22 +103,6 @@ func Update(issueKeyOrID string, opts UpdateOptions) error {
 
 	markdown := backlog.FormatIssueMarkdown(issue)
 
-	renderer, err := glamour.NewTermRenderer(
-		glamour.WithAutoStyle(),
-		glamour.WithWordWrap(100),
-	)
-	if err != nil {
-		// Fallback to plain output if renderer fails
-		fmt.Print(markdown)
-		return nil
-	}
-
-	rendered, err := renderer.Render(markdown)
-	if err != nil {
-		fmt.Print(markdown)
-		return nil
-	}
-
-	fmt.Print(rendered)
+	render.Markdown(markdown)
 	return nil
 }
@@ -1,11 +1,8 @@
 package issue
 
 import (
-	"encoding/json"
-	"fmt"
-
-	"github.com/charmbracelet/glamour"
 	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
 )
 
 // ViewOptions contains options for the view command.
@@ -26,19 +23,7 @@ func View(issueKeyOrID string, opts ViewOptions) error {
 	}
 
 	if opts.Raw {
-		// Pretty print JSON
-		var prettyJSON map[string]any
-		if err := json.Unmarshal(data, &prettyJSON); err != nil {
-			// If pretty print fails, output raw
-			fmt.Println(string(data))
-			return nil
-		}
-		formatted, err := json.MarshalIndent(prettyJSON, "", "  ")
-		if err != nil {
-			fmt.Println(string(data))
-			return nil
-		}
-		fmt.Println(string(formatted))
+		render.JSON(data)
 		return nil
 	}
 
@@ -49,22 +34,6 @@ func View(issueKeyOrID string, opts ViewOptions) error {
 
 	markdown := backlog.FormatIssueMarkdown(issue)
 
-	renderer, err := glamour.NewTermRenderer(
-		glamour.WithAutoStyle(),
-		glamour.WithWordWrap(100),
-	)
-	if err != nil {
-		// Fallback to plain output if renderer fails
-		fmt.Print(markdown)
-		return nil
-	}
-
-	rendered, err := renderer.Render(markdown)
-	if err != nil {
-		fmt.Print(markdown)
-		return nil
-	}
-
-	fmt.Print(rendered)
+	render.Markdown(markdown)
 	return nil
 }
@@ -0,0 +1,68 @@
+package issuetype
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// AddOptions contains options for the add command.
+type AddOptions struct {
+	Raw   bool
+	Yes   bool
+	Name  string
+	Color string
+}
+
+// Add adds an issue type to a project after confirming its name and color.
+func Add(projectIDOrKey string, opts AddOptions) error {
+	color := strings.ToLower(opts.Color)
+	if !slices.Contains(backlog.IssueTypeColors, color) {
+		return fmt.Errorf("invalid color: %s (must be one of %s)", opts.Color, strings.Join(backlog.IssueTypeColors, ", "))
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		confirm, err := prompt.Confirm("Add Issue Type?", "Add", []string{
+			"Space: " + client.GetSpace(),
+			"Project: " + projectIDOrKey,
+			"Name: " + opts.Name,
+			"Color: " + color,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	data, err := client.AddIssueType(projectIDOrKey, opts.Name, color)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	issueType, err := backlog.ParseIssueType(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Issue type added: %s (id: %d)\n", issueType.Name, issueType.ID)
+	return nil
+}
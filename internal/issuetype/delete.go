@@ -0,0 +1,78 @@
+package issuetype
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// DeleteOptions contains options for the delete command.
+type DeleteOptions struct {
+	Raw        bool
+	Yes        bool
+	Substitute string
+}
+
+// Delete deletes an issue type from a project. Issues of the deleted type
+// are moved to the substitute issue type. Both issue types may be given by
+// ID or name.
+func Delete(projectIDOrKey string, issueTypeIDOrName string, opts DeleteOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetIssueTypes(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+	issueTypes, err := backlog.ParseIssueTypes(data)
+	if err != nil {
+		return err
+	}
+
+	target, err := backlog.FindIssueType(issueTypes, issueTypeIDOrName)
+	if err != nil {
+		return err
+	}
+	substitute, err := backlog.FindIssueType(issueTypes, opts.Substitute)
+	if err != nil {
+		return err
+	}
+	if target.ID == substitute.ID {
+		return fmt.Errorf("substitute issue type must differ from the deleted one")
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		confirm, err := prompt.Confirm("Delete Issue Type?", "Delete", []string{
+			"Space: " + client.GetSpace(),
+			"Project: " + projectIDOrKey,
+			fmt.Sprintf("Issue type: %s (id: %d)", target.Name, target.ID),
+			fmt.Sprintf("Existing issues move to: %s (id: %d)", substitute.Name, substitute.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	data, err = client.DeleteIssueType(projectIDOrKey, target.ID, substitute.ID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fmt.Printf("Issue type deleted: %s\n", target.Name)
+	return nil
+}
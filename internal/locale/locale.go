@@ -0,0 +1,165 @@
+package locale
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dannygim/bgl/internal/config"
+)
+
+// dateLayouts maps the supported date format settings to Go layouts.
+var dateLayouts = map[string]string{
+	"yyyy-MM-dd": "2006-01-02",
+	"yyyy/MM/dd": "2006/01/02",
+	"dd.MM.yyyy": "02.01.2006",
+	"dd/MM/yyyy": "02/01/2006",
+	"MM/dd/yyyy": "01/02/2006",
+}
+
+// DateFormats lists the accepted values of the date_format setting.
+var DateFormats = []string{"yyyy-MM-dd", "yyyy/MM/dd", "dd.MM.yyyy", "dd/MM/yyyy", "MM/dd/yyyy"}
+
+// settings is the resolved locale configuration.
+type settings struct {
+	dateLayout string
+	separator  string
+	weekStart  time.Weekday
+}
+
+var (
+	loadOnce sync.Once
+	current  settings
+)
+
+// get loads the locale settings from the config once. Unknown or missing
+// values fall back to yyyy-MM-dd, no thousands separator, and Monday.
+func get() settings {
+	loadOnce.Do(func() {
+		current = settings{dateLayout: "2006-01-02", weekStart: time.Monday}
+
+		cfg, err := config.Load()
+		if err != nil || cfg.Locale == nil {
+			return
+		}
+		if layout, ok := dateLayouts[cfg.Locale.DateFormat]; ok {
+			current.dateLayout = layout
+		}
+		current.separator = cfg.Locale.ThousandsSeparator
+		if strings.EqualFold(cfg.Locale.WeekStart, "sunday") {
+			current.weekStart = time.Sunday
+		}
+	})
+	return current
+}
+
+// Date formats the date part of t.
+func Date(t time.Time) string {
+	return t.Format(get().dateLayout)
+}
+
+// DateTime formats t as a date followed by hours and minutes.
+func DateTime(t time.Time) string {
+	return t.Format(get().dateLayout + " 15:04")
+}
+
+// DateString formats a Backlog date or datetime (e.g. 2024-01-01T00:00:00Z)
+// as a date. Datetimes are shown in local time. Unparsable values are
+// returned as-is.
+func DateString(s string) string {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		// Date-only fields are sent as midnight UTC; keep their day
+		if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+			return Date(t)
+		}
+		return Date(t.Local())
+	}
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return Date(t)
+	}
+	return s
+}
+
+// DateTimeString formats a Backlog datetime in local time. Unparsable
+// values are returned as-is.
+func DateTimeString(s string) string {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return DateTime(t.Local())
+	}
+	return s
+}
+
+// Number formats an integer with the configured thousands separator.
+func Number(n int64) string {
+	digits := strconv.FormatInt(n, 10)
+	separator := get().separator
+	if separator == "" {
+		return digits
+	}
+
+	sign := ""
+	if n < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	var sb strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			sb.WriteString(separator)
+		}
+		sb.WriteRune(digit)
+	}
+	return sign + sb.String()
+}
+
+// Decimal formats a number such as hours with the given precision and the
+// configured thousands separator. A "." separator switches the decimal
+// mark to ",".
+func Decimal(f float64, precision int) string {
+	s := strconv.FormatFloat(f, 'f', precision, 64)
+	whole, fraction, found := strings.Cut(s, ".")
+
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return s
+	}
+	whole = Number(n)
+	if whole == "0" && strings.HasPrefix(s, "-") {
+		whole = "-0"
+	}
+	if !found {
+		return whole
+	}
+
+	mark := "."
+	if get().separator == "." {
+		mark = ","
+	}
+	return whole + mark + fraction
+}
+
+// Size formats a byte count in binary units (KB, MB, ...) with one
+// decimal, using the configured decimal mark.
+func Size(bytes int64) string {
+	if bytes < 1024 {
+		return Number(bytes) + " B"
+	}
+	value := float64(bytes)
+	unit := ""
+	for _, u := range []string{"KB", "MB", "GB", "TB"} {
+		value /= 1024
+		unit = u
+		if value < 1024 {
+			break
+		}
+	}
+	return Decimal(value, 1) + " " + unit
+}
+
+// WeekStart returns midnight of the first day of the week containing t.
+func WeekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) - int(get().weekStart) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
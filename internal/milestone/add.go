@@ -0,0 +1,105 @@
+package milestone
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// AddOptions contains options for the add command. Dates are yyyy-MM-dd.
+type AddOptions struct {
+	Raw         bool
+	Yes         bool
+	Description string
+	StartDate   string
+	DueDate     string
+}
+
+// Add adds a version/milestone to a project.
+func Add(projectIDOrKey string, name string, opts AddOptions) error {
+	if err := validateDates(opts.StartDate, opts.DueDate); err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data := url.Values{}
+	data.Set("name", name)
+	if opts.Description != "" {
+		data.Set("description", opts.Description)
+	}
+	if opts.StartDate != "" {
+		data.Set("startDate", opts.StartDate)
+	}
+	if opts.DueDate != "" {
+		data.Set("releaseDueDate", opts.DueDate)
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		details := []string{"Space: " + client.GetSpace(), "Project: " + projectIDOrKey, "Name: " + name}
+		details = append(details, describe(data)...)
+		ok, err := prompt.Confirm("Add Version/Milestone?", "Add", details)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	result, err := client.AddVersion(projectIDOrKey, data)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	version, err := backlog.ParseVersion(result)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Version/milestone added: %s (id: %d)\n", version.Name, version.ID)
+	return nil
+}
+
+// validateDates checks that each non-empty date is yyyy-MM-dd.
+func validateDates(dates ...string) error {
+	for _, date := range dates {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return fmt.Errorf("invalid date %q (expected yyyy-MM-dd)", date)
+		}
+	}
+	return nil
+}
+
+// describe lists the optional fields set in data for a confirmation prompt.
+func describe(data url.Values) []string {
+	var details []string
+	for _, field := range []struct{ key, label string }{
+		{"description", "Description"},
+		{"startDate", "Start"},
+		{"releaseDueDate", "Due"},
+		{"archived", "Archived"},
+	} {
+		if value, ok := data[field.key]; ok {
+			details = append(details, field.label+": "+value[0])
+		}
+	}
+	return details
+}
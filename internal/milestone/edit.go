@@ -0,0 +1,120 @@
+package milestone
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// EditOptions contains options for the edit command. Empty fields are left
+// unchanged; Archived is applied only if non-nil. Dates are yyyy-MM-dd.
+type EditOptions struct {
+	Raw         bool
+	Yes         bool
+	Name        string
+	Description string
+	StartDate   string
+	DueDate     string
+	Archived    *bool
+}
+
+// Edit updates a version/milestone, given by ID or name.
+func Edit(projectIDOrKey string, versionIDOrName string, opts EditOptions) error {
+	if err := validateDates(opts.StartDate, opts.DueDate); err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	version, err := findVersion(client, projectIDOrKey, versionIDOrName)
+	if err != nil {
+		return err
+	}
+
+	// The API requires the name even when it does not change
+	data := url.Values{}
+	name := version.Name
+	if opts.Name != "" {
+		name = opts.Name
+	}
+	data.Set("name", name)
+	if opts.Description != "" {
+		data.Set("description", opts.Description)
+	}
+	if opts.StartDate != "" {
+		data.Set("startDate", opts.StartDate)
+	}
+	if opts.DueDate != "" {
+		data.Set("releaseDueDate", opts.DueDate)
+	}
+	if opts.Archived != nil {
+		data.Set("archived", strconv.FormatBool(*opts.Archived))
+	}
+
+	if len(data) == 1 && name == version.Name {
+		return fmt.Errorf("nothing to update")
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		details := []string{"Space: " + client.GetSpace(), "Project: " + projectIDOrKey, "Version/milestone: " + version.Name}
+		if name != version.Name {
+			details = append(details, "Name: "+name)
+		}
+		details = append(details, describe(data)...)
+		ok, err := prompt.Confirm("Update Version/Milestone?", "Update", details)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	result, err := client.UpdateVersion(projectIDOrKey, version.ID, data)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	fmt.Printf("Version/milestone updated: %s\n", name)
+	return nil
+}
+
+// CloseOptions contains options for the close command.
+type CloseOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// Close archives a version/milestone, given by ID or name, once it has
+// been released.
+func Close(projectIDOrKey string, versionIDOrName string, opts CloseOptions) error {
+	archived := true
+	return Edit(projectIDOrKey, versionIDOrName, EditOptions{Raw: opts.Raw, Yes: opts.Yes, Archived: &archived})
+}
+
+// findVersion looks up a project's version/milestone by ID or name.
+func findVersion(client *backlog.Client, projectIDOrKey string, versionIDOrName string) (*backlog.Version, error) {
+	data, err := client.GetVersions(projectIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := backlog.ParseVersions(data)
+	if err != nil {
+		return nil, err
+	}
+	return backlog.FindVersion(versions, versionIDOrName)
+}
@@ -0,0 +1,233 @@
+package next
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/config"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// IDs of Backlog's built-in "Resolved" and "Closed" statuses.
+const (
+	resolvedStatusID = 3
+	closedStatusID   = 4
+)
+
+// maxIssueCount is the largest page the issue list API returns.
+const maxIssueCount = 100
+
+// defaultWeights are used when no weights are configured.
+var defaultWeights = config.NextWeights{Due: 1, Priority: 1, Staleness: 1}
+
+// Options contains options for the next command.
+type Options struct {
+	Raw   bool
+	Count int
+}
+
+// rankedIssue is an issue with its score and the reasons for it.
+type rankedIssue struct {
+	IssueKey string   `json:"issueKey"`
+	Summary  string   `json:"summary"`
+	Score    float64  `json:"score"`
+	Reasons  []string `json:"reasons"`
+}
+
+// Next displays my open issues ranked by due date, priority, and staleness.
+func Next(opts Options) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	weights := defaultWeights
+	if cfg.Next != nil {
+		weights = *cfg.Next
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetMyself()
+	if err != nil {
+		return err
+	}
+	me, err := backlog.ParseUser(data)
+	if err != nil {
+		return err
+	}
+
+	issues, err := fetchOpenIssues(client, me.ID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var ranked []rankedIssue
+	for _, issue := range issues {
+		ranked = append(ranked, rank(issue, weights, now))
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	if opts.Count > 0 && len(ranked) > opts.Count {
+		ranked = ranked[:opts.Count]
+	}
+
+	if opts.Raw {
+		if ranked == nil {
+			ranked = []rankedIssue{}
+		}
+		formatted, err := json.MarshalIndent(ranked, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+		return nil
+	}
+
+	if len(ranked) == 0 {
+		fmt.Println("No open issues assigned to you.")
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("## Next\n")
+	for i, r := range ranked {
+		fmt.Fprintf(&sb, "%d. **%s** %s (score: %.1f)\n", i+1, r.IssueKey, r.Summary, r.Score)
+		if len(r.Reasons) > 0 {
+			fmt.Fprintf(&sb, "   - %s\n", strings.Join(r.Reasons, ", "))
+		}
+	}
+	markdown := sb.String()
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// fetchOpenIssues returns every issue assigned to the user that is not
+// resolved or closed, paging with offset. Projects can add their own
+// statuses, so the open ones are looked up first and passed as statusId[]
+// to keep finished issues out of the response.
+func fetchOpenIssues(client *backlog.Client, userID int) ([]backlog.Issue, error) {
+	statusIDs, err := openStatusIDs(client)
+	if err != nil {
+		return nil, err
+	}
+	if len(statusIDs) == 0 {
+		return nil, nil
+	}
+
+	query := url.Values{}
+	query.Set("assigneeId[]", strconv.Itoa(userID))
+	query["statusId[]"] = statusIDs
+	query.Set("count", strconv.Itoa(maxIssueCount))
+
+	var open []backlog.Issue
+	for offset := 0; ; offset += maxIssueCount {
+		query.Set("offset", strconv.Itoa(offset))
+		data, err := client.GetIssues(query)
+		if err != nil {
+			return nil, err
+		}
+		issues, err := backlog.ParseIssues(data)
+		if err != nil {
+			return nil, err
+		}
+		open = append(open, issues...)
+		if len(issues) < maxIssueCount {
+			return open, nil
+		}
+	}
+}
+
+// openStatusIDs returns the IDs of every status in the user's projects
+// other than Resolved and Closed.
+func openStatusIDs(client *backlog.Client) ([]string, error) {
+	data, err := client.GetProjects()
+	if err != nil {
+		return nil, err
+	}
+	projects, err := backlog.ParseProjects(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	seen := map[int]bool{}
+	for _, project := range projects {
+		data, err := client.GetProjectStatuses(strconv.Itoa(project.ID))
+		if err != nil {
+			return nil, err
+		}
+		statuses, err := backlog.ParseProjectStatuses(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, status := range statuses {
+			if status.ID == resolvedStatusID || status.ID == closedStatusID || seen[status.ID] {
+				continue
+			}
+			seen[status.ID] = true
+			ids = append(ids, strconv.Itoa(status.ID))
+		}
+	}
+	return ids, nil
+}
+
+// rank scores an issue. Each factor scores roughly 0-3 before weighting:
+// overdue or due soon, higher priority, and longer without updates all
+// raise the score.
+func rank(issue backlog.Issue, weights config.NextWeights, now time.Time) rankedIssue {
+	r := rankedIssue{IssueKey: issue.IssueKey, Summary: issue.Summary}
+
+	if due, err := time.Parse(time.RFC3339, issue.DueDate); err == nil {
+		days := int(math.Floor(due.Sub(now).Hours() / 24))
+		var score float64
+		switch {
+		case days < 0:
+			score = 3
+			r.Reasons = append(r.Reasons, fmt.Sprintf("overdue by %d day(s)", -days))
+		case days == 0:
+			score = 3
+			r.Reasons = append(r.Reasons, "due today")
+		case days <= 3:
+			score = 2
+			r.Reasons = append(r.Reasons, fmt.Sprintf("due in %d day(s)", days))
+		case days <= 7:
+			score = 1
+			r.Reasons = append(r.Reasons, fmt.Sprintf("due in %d day(s)", days))
+		}
+		r.Score += weights.Due * score
+	}
+
+	if issue.Priority != nil {
+		// Backlog priority IDs: 2 = High, 3 = Normal, 4 = Low
+		score := math.Max(0, float64(4-issue.Priority.ID))
+		if issue.Priority.ID == 2 {
+			r.Reasons = append(r.Reasons, issue.Priority.Name+" priority")
+		}
+		r.Score += weights.Priority * score
+	}
+
+	if updated, err := time.Parse(time.RFC3339, issue.Updated); err == nil {
+		days := int(now.Sub(updated).Hours() / 24)
+		score := math.Min(3, float64(days)/7)
+		if days >= 7 {
+			r.Reasons = append(r.Reasons, fmt.Sprintf("not updated for %d day(s)", days))
+		}
+		r.Score += weights.Staleness * score
+	}
+
+	return r
+}
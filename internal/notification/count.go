@@ -0,0 +1,126 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/config"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// countCacheFileName is the name of the notification count cache in the
+// state dir.
+const countCacheFileName = "notification-count.json"
+
+// countCacheTTL is how long a cached count is printed without asking the
+// API, so shell prompts redrawn often stay fast.
+const countCacheTTL = 30 * time.Second
+
+// cachedCount is a notification count cached for a space and filter.
+type cachedCount struct {
+	Count     int       `json:"count"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// CountOptions contains options for the count command.
+type CountOptions struct {
+	Raw    bool
+	Unread bool
+}
+
+// Count prints the number of notifications as a bare integer. Counts are
+// cached for countCacheTTL; --raw always asks the API.
+func Count(opts CountOptions) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	key := cfg.Space + "/all"
+	if opts.Unread {
+		key = cfg.Space + "/unread"
+	}
+
+	cache := loadCountCache()
+	if cached, ok := cache[key]; ok && !opts.Raw && time.Since(cached.CheckedAt) < countCacheTTL {
+		fmt.Println(cached.Count)
+		return nil
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	if opts.Unread {
+		query.Set("alreadyRead", "false")
+	}
+	data, err := client.GetNotificationCount(query)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	count, err := backlog.ParseCount(data)
+	if err != nil {
+		return err
+	}
+
+	cache[key] = &cachedCount{Count: count.Count, CheckedAt: time.Now()}
+	// A failed cache write only costs a request next time
+	_ = saveCountCache(cache)
+
+	fmt.Println(count.Count)
+	return nil
+}
+
+func getCountCachePath() (string, error) {
+	stateDir, err := config.GetStateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(stateDir, countCacheFileName), nil
+}
+
+// loadCountCache reads the cache, keyed by space and filter.
+func loadCountCache() map[string]*cachedCount {
+	cache := map[string]*cachedCount{}
+	path, err := getCountCachePath()
+	if err != nil {
+		return cache
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	_ = json.Unmarshal(data, &cache)
+	return cache
+}
+
+func saveCountCache(cache map[string]*cachedCount) error {
+	stateDir, err := config.GetStateDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return err
+	}
+	path, err := getCountCachePath()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
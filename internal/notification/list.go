@@ -0,0 +1,110 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// maxNotificationCount is the largest page the notifications API returns.
+const maxNotificationCount = 100
+
+// maxUnreadScan is how many of the newest notifications are searched for
+// unread ones, so a long read history isn't paged through.
+const maxUnreadScan = 500
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw    bool
+	Unread bool
+	Limit  int
+}
+
+// List displays the notification inbox, newest first.
+func List(opts ListOptions) error {
+	if opts.Limit <= 0 {
+		opts.Limit = 20
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	entries, notifications, err := fetchNotifications(client, opts.Limit, opts.Unread)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		formatted, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+		return nil
+	}
+
+	markdown := backlog.FormatNotificationsMarkdown(notifications)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// fetchNotifications fetches up to limit notifications, newest first,
+// paging back with maxId. With unread, only unread notifications among the
+// newest maxUnreadScan are kept. It returns the raw entries alongside the
+// parsed notifications.
+func fetchNotifications(client *backlog.Client, limit int, unread bool) ([]json.RawMessage, []backlog.Notification, error) {
+	entries := []json.RawMessage{}
+	notifications := []backlog.Notification{}
+	seen := map[int]bool{}
+	scanned := 0
+
+	query := url.Values{}
+	for len(notifications) < limit {
+		count := min(limit-len(notifications), maxNotificationCount)
+		if unread {
+			count = maxNotificationCount
+		}
+		query.Set("count", strconv.Itoa(count))
+		data, err := client.GetNotifications(query)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var page []json.RawMessage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse notifications: %w", err)
+		}
+		parsed, err := backlog.ParseNotifications(data)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Whether maxId is inclusive or not, skip anything already fetched
+		fetched := 0
+		for i, notification := range parsed {
+			if seen[notification.ID] {
+				continue
+			}
+			seen[notification.ID] = true
+			fetched++
+			if (unread && notification.AlreadyRead) || len(notifications) >= limit {
+				continue
+			}
+			entries = append(entries, page[i])
+			notifications = append(notifications, notification)
+		}
+		scanned += fetched
+		if fetched == 0 || len(page) < count || (unread && scanned >= maxUnreadScan) {
+			break
+		}
+		query.Set("maxId", strconv.Itoa(parsed[len(parsed)-1].ID))
+	}
+	return entries, notifications, nil
+}
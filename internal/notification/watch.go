@@ -0,0 +1,155 @@
+package notification
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// MinWatchInterval is the shortest polling interval allowed, to stay well
+// within the API rate limits.
+const MinWatchInterval = 10 * time.Second
+
+// WatchOptions contains options for the watch command. With Desktop, each
+// new notification is also shown as a desktop notification.
+type WatchOptions struct {
+	Interval time.Duration
+	Desktop  bool
+}
+
+// Watch polls the notification inbox every Interval and prints new
+// notifications as they arrive, until interrupted. Failed polls are
+// reported and retried at the next interval.
+func Watch(opts WatchOptions) error {
+	if opts.Interval == 0 {
+		opts.Interval = 60 * time.Second
+	}
+	if opts.Interval < MinWatchInterval {
+		return fmt.Errorf("interval must be at least %s", MinWatchInterval)
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// Only notifications newer than the latest one at start are shown
+	lastID, err := latestNotificationID(client)
+	if err != nil {
+		return err
+	}
+
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+
+	fmt.Printf("Watching notifications every %s. Press Ctrl-C to stop.\n", opts.Interval)
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-interrupted:
+			return nil
+		case <-ticker.C:
+		}
+
+		notifications, err := newNotifications(client, lastID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			continue
+		}
+		if len(notifications) == 0 {
+			continue
+		}
+		lastID = notifications[len(notifications)-1].ID
+
+		var sb strings.Builder
+		for i := range notifications {
+			sb.WriteString(backlog.FormatNotificationMarkdownLine(&notifications[i]))
+		}
+		render.Markdown(sb.String())
+
+		if opts.Desktop {
+			for i := range notifications {
+				title, body := desktopText(&notifications[i])
+				if err := sendDesktop(title, body); err != nil {
+					fmt.Fprintf(os.Stderr, "Error: failed to send desktop notification: %v\n", err)
+					opts.Desktop = false
+					break
+				}
+			}
+		}
+	}
+}
+
+// latestNotificationID returns the ID of the newest notification, or 0 if
+// there are none.
+func latestNotificationID(client *backlog.Client) (int, error) {
+	query := url.Values{}
+	query.Set("count", "1")
+	data, err := client.GetNotifications(query)
+	if err != nil {
+		return 0, err
+	}
+	notifications, err := backlog.ParseNotifications(data)
+	if err != nil {
+		return 0, err
+	}
+	if len(notifications) == 0 {
+		return 0, nil
+	}
+	return notifications[0].ID, nil
+}
+
+// newNotifications returns the notifications newer than lastID, oldest
+// first.
+func newNotifications(client *backlog.Client, lastID int) ([]backlog.Notification, error) {
+	query := url.Values{}
+	query.Set("minId", strconv.Itoa(lastID))
+	query.Set("count", strconv.Itoa(maxNotificationCount))
+	query.Set("order", "asc")
+	data, err := client.GetNotifications(query)
+	if err != nil {
+		return nil, err
+	}
+	notifications, err := backlog.ParseNotifications(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Whether minId is inclusive or not, drop anything already shown
+	var fresh []backlog.Notification
+	for _, notification := range notifications {
+		if notification.ID > lastID {
+			fresh = append(fresh, notification)
+		}
+	}
+	return fresh, nil
+}
+
+// desktopText returns the title and body of a desktop notification.
+func desktopText(notification *backlog.Notification) (string, string) {
+	sender := "Backlog"
+	if notification.Sender != nil {
+		sender = notification.Sender.Name
+	}
+	title := fmt.Sprintf("%s: %s", sender, backlog.NotificationReasonLabel(notification.Reason))
+
+	switch {
+	case notification.PullRequest != nil:
+		return title, fmt.Sprintf("#%d %s", notification.PullRequest.Number, notification.PullRequest.Summary)
+	case notification.Issue != nil:
+		return title, notification.Issue.IssueKey + " " + notification.Issue.Summary
+	case notification.Project != nil:
+		return title, notification.Project.Name
+	}
+	return title, ""
+}
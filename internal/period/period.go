@@ -0,0 +1,103 @@
+package period
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/dannygim/bgl/internal/locale"
+)
+
+// Period is a half-open time range [Start, End).
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains reports whether t falls within the period.
+func (p Period) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+var (
+	isoWeekPattern = regexp.MustCompile(`^(\d{4})-[Ww](\d{1,2})$`)
+	quarterPattern = regexp.MustCompile(`^(\d{4})-[Qq]([1-4])$`)
+	monthPattern   = regexp.MustCompile(`^(\d{4})-(\d{2})$`)
+)
+
+// Names lists the accepted period forms, for help and error messages.
+const Names = "today, yesterday, this-week, last-week, this-month, last-month, yyyy-Www, yyyy-Qn, or yyyy-MM"
+
+// Parse parses a period relative to now, in now's location. Relative weeks
+// start on the configured week start day; yyyy-Www is an ISO 8601 week,
+// which always starts on Monday. Boundaries are midnights computed with
+// calendar arithmetic, so days stay whole across DST changes.
+func Parse(s string, now time.Time) (Period, error) {
+	loc := now.Location()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	switch s {
+	case "today":
+		return Period{today, today.AddDate(0, 0, 1)}, nil
+	case "yesterday":
+		return Period{today.AddDate(0, 0, -1), today}, nil
+	case "this-week":
+		start := locale.WeekStart(now)
+		return Period{start, start.AddDate(0, 0, 7)}, nil
+	case "last-week":
+		start := locale.WeekStart(now).AddDate(0, 0, -7)
+		return Period{start, start.AddDate(0, 0, 7)}, nil
+	case "this-month":
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+		return Period{start, start.AddDate(0, 1, 0)}, nil
+	case "last-month":
+		start := time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, loc)
+		return Period{start, start.AddDate(0, 1, 0)}, nil
+	}
+
+	if m := isoWeekPattern.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		week, _ := strconv.Atoi(m[2])
+		start, err := isoWeekStart(year, week, loc)
+		if err != nil {
+			return Period{}, err
+		}
+		return Period{start, start.AddDate(0, 0, 7)}, nil
+	}
+
+	if m := quarterPattern.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		quarter, _ := strconv.Atoi(m[2])
+		start := time.Date(year, time.Month(3*(quarter-1)+1), 1, 0, 0, 0, 0, loc)
+		return Period{start, start.AddDate(0, 3, 0)}, nil
+	}
+
+	if m := monthPattern.FindStringSubmatch(s); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		if month >= 1 && month <= 12 {
+			start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
+			return Period{start, start.AddDate(0, 1, 0)}, nil
+		}
+	}
+
+	return Period{}, fmt.Errorf("invalid period %q (expected %s)", s, Names)
+}
+
+// isoWeekStart returns the Monday starting ISO week of year. Week 1 is the
+// week containing January 4th, so it may start in the previous year, and
+// some years have a week 53.
+func isoWeekStart(year int, week int, loc *time.Location) (time.Time, error) {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, loc)
+	offset := (int(jan4.Weekday()) + 6) % 7 // days since Monday
+	start := jan4.AddDate(0, 0, -offset+7*(week-1))
+
+	if week < 1 || week > 53 {
+		return time.Time{}, fmt.Errorf("invalid ISO week %d-W%02d", year, week)
+	}
+	if y, w := start.ISOWeek(); y != year || w != week {
+		return time.Time{}, fmt.Errorf("%d has no ISO week %d", year, week)
+	}
+	return start, nil
+}
@@ -0,0 +1,77 @@
+package pr
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/locale"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// AttachmentsOptions contains options for the attachments command. With
+// Download, every attachment is saved into Dir (default: the current
+// directory) instead of being listed.
+type AttachmentsOptions struct {
+	Raw      bool
+	Download bool
+	Dir      string
+}
+
+// Attachments lists the attachments of a pull request, or downloads them
+// all.
+func Attachments(projectIDOrKey string, repoIDOrName string, number string, opts AttachmentsOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	data, err := client.GetPullRequestAttachments(projectIDOrKey, repoIDOrName, number)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw && !opts.Download {
+		render.JSON(data)
+		return nil
+	}
+
+	attachments, err := backlog.ParseAttachments(data)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Download {
+		render.Markdown(backlog.FormatAttachmentsMarkdown(attachments))
+		return nil
+	}
+
+	if len(attachments) == 0 {
+		fmt.Println("No attachments found.")
+		return nil
+	}
+
+	if opts.Dir != "" {
+		if err := os.MkdirAll(opts.Dir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+	}
+	for _, attachment := range attachments {
+		content, _, err := client.DownloadPullRequestAttachment(projectIDOrKey, repoIDOrName, number, strconv.Itoa(attachment.ID))
+		if err != nil {
+			return fmt.Errorf("failed to download %s: %w", attachment.Name, err)
+		}
+		out := filepath.Join(opts.Dir, filepath.Base(attachment.Name))
+		if err := os.WriteFile(out, content, 0644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("Downloaded: %s (%s bytes)\n", out, locale.Number(int64(len(content))))
+	}
+	return nil
+}
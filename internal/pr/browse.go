@@ -0,0 +1,46 @@
+package pr
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/auth"
+	"github.com/dannygim/bgl/internal/backlog"
+)
+
+// Browse opens a pull request in the web browser.
+func Browse(projectIDOrKey string, repoIDOrName string, number string) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	// Fetch the pull request first so a bad number fails here, not in the browser
+	data, err := client.GetPullRequest(projectIDOrKey, repoIDOrName, number)
+	if err != nil {
+		return err
+	}
+	pr, err := backlog.ParsePullRequest(data)
+	if err != nil {
+		return err
+	}
+
+	data, err = client.GetGitRepository(projectIDOrKey, repoIDOrName)
+	if err != nil {
+		return err
+	}
+	repo, err := backlog.ParseRepository(data)
+	if err != nil {
+		return err
+	}
+
+	prURL := backlog.PullRequestURL(repo, pr.Number)
+	fmt.Printf("Opening %s in your browser.\n", prURL)
+	if err := auth.OpenBrowser(prURL); err != nil {
+		return fmt.Errorf("failed to open browser: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package pr
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/git"
+)
+
+// Checkout checks out the source branch of a pull request in the current
+// directory, which must be a clone of a Backlog Git repository. The branch
+// is fetched from the Backlog remote; an existing local branch is
+// fast-forwarded, otherwise a tracking branch is created.
+func Checkout(number string) error {
+	remote, err := git.BacklogRemote()
+	if err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetPullRequest(remote.ProjectKey, remote.Repo, number)
+	if err != nil {
+		return err
+	}
+	pr, err := backlog.ParsePullRequest(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Checking out #%d %s (%s)\n", pr.Number, pr.Summary, pr.Branch)
+
+	remoteBranch := remote.Name + "/" + pr.Branch
+	if err := git.Interactive("fetch", remote.Name, "+refs/heads/"+pr.Branch+":refs/remotes/"+remoteBranch); err != nil {
+		return err
+	}
+
+	if git.BranchExists(pr.Branch) {
+		if err := git.Interactive("checkout", pr.Branch); err != nil {
+			return err
+		}
+		return git.Interactive("merge", "--ff-only", remoteBranch)
+	}
+	return git.Interactive("checkout", "-b", pr.Branch, "--track", remoteBranch)
+}
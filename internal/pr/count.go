@@ -0,0 +1,52 @@
+package pr
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// CountOptions contains options for the count command. Status and
+// Assignee filter as in ListOptions.
+type CountOptions struct {
+	Raw      bool
+	Status   string
+	Assignee string
+}
+
+// Count prints the number of pull requests in a repository as a bare
+// integer.
+func Count(projectIDOrKey string, repoIDOrName string, opts CountOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	query, err := filterQuery(client, projectIDOrKey, opts.Status, opts.Assignee)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetPullRequestCount(projectIDOrKey, repoIDOrName, query)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	count, err := backlog.ParseCount(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(count.Count)
+	return nil
+}
@@ -0,0 +1,146 @@
+package pr
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/git"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// CreateOptions contains options for the create command. Branch defaults
+// to the current git branch. Issue is the key or ID of the issue to link;
+// Assignee is "me" or a user ID, name, or mail address.
+type CreateOptions struct {
+	Raw         bool
+	Yes         bool
+	Base        string
+	Branch      string
+	Title       string
+	Description string
+	BodyFile    string
+	Issue       string
+	Assignee    string
+}
+
+// Create opens a pull request from a branch into a base branch.
+func Create(projectIDOrKey string, repoIDOrName string, opts CreateOptions) error {
+	if opts.Base == "" {
+		return fmt.Errorf("--base is required")
+	}
+	if strings.TrimSpace(opts.Title) == "" {
+		return fmt.Errorf("--title is required")
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		current, err := git.CurrentBranch()
+		if err != nil {
+			return fmt.Errorf("--branch is required: %w", err)
+		}
+		branch = current
+	}
+	if branch == opts.Base {
+		return fmt.Errorf("branch and base are both %s", branch)
+	}
+
+	description := opts.Description
+	if description == "" && opts.BodyFile != "" {
+		var data []byte
+		var err error
+		if opts.BodyFile == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(opts.BodyFile)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read description: %w", err)
+		}
+		description = strings.TrimRight(string(data), "\n")
+	}
+
+	if err := secrets.Check(opts.Title + "\n" + description); err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	data := url.Values{}
+	data.Set("summary", opts.Title)
+	data.Set("description", description)
+	data.Set("base", opts.Base)
+	data.Set("branch", branch)
+
+	details := []string{
+		"Space: " + client.GetSpace(),
+		"Repository: " + projectIDOrKey + "/" + repoIDOrName,
+		"Branch: " + branch + " → " + opts.Base,
+		"Title: " + opts.Title,
+	}
+
+	if opts.Issue != "" {
+		issueData, err := client.GetIssue(opts.Issue)
+		if err != nil {
+			return err
+		}
+		issue, err := backlog.ParseIssue(issueData)
+		if err != nil {
+			return err
+		}
+		data.Set("issueId", strconv.Itoa(issue.ID))
+		details = append(details, "Issue: "+issue.IssueKey+" "+issue.Summary)
+	}
+
+	if opts.Assignee != "" {
+		user, err := resolveUser(client, projectIDOrKey, opts.Assignee)
+		if err != nil {
+			return err
+		}
+		data.Set("assigneeId", strconv.Itoa(user.ID))
+		details = append(details, "Assignee: "+user.Name)
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		ok, err := prompt.Confirm("Create Pull Request?", "Create", details)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	result, err := client.AddPullRequest(projectIDOrKey, repoIDOrName, data)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	pr, err := backlog.ParsePullRequest(result)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Pull request created: #%d %s\n", pr.Number, pr.Summary)
+	return nil
+}
@@ -0,0 +1,161 @@
+package pr
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// EditOptions contains options for the edit command. Only the fields that
+// are set are changed. Assignee is "me" or a user ID, name, or mail
+// address; Issue is the key or ID of the issue to link. Comment is posted
+// on the pull request along with the change.
+type EditOptions struct {
+	Raw         bool
+	Yes         bool
+	Title       string
+	Description string
+	BodyFile    string
+	Assignee    string
+	Issue       string
+	Comment     string
+}
+
+// Edit updates a pull request's title, description, assignee, or linked
+// issue. The current and new values are shown for confirmation.
+func Edit(projectIDOrKey string, repoIDOrName string, number string, opts EditOptions) error {
+	description := opts.Description
+	if description == "" && opts.BodyFile != "" {
+		var data []byte
+		var err error
+		if opts.BodyFile == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(opts.BodyFile)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read description: %w", err)
+		}
+		description = strings.TrimRight(string(data), "\n")
+	}
+
+	if opts.Title == "" && description == "" && opts.Assignee == "" && opts.Issue == "" && opts.Comment == "" {
+		return fmt.Errorf("nothing to change (use --title, --description, --body-file, --assignee, --issue, or --comment)")
+	}
+
+	if err := secrets.Check(opts.Title + "\n" + description + "\n" + opts.Comment); err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	data, err := client.GetPullRequest(projectIDOrKey, repoIDOrName, number)
+	if err != nil {
+		return err
+	}
+	current, err := backlog.ParsePullRequest(data)
+	if err != nil {
+		return err
+	}
+
+	update := url.Values{}
+	details := []string{
+		"Space: " + client.GetSpace(),
+		fmt.Sprintf("Pull request: %s/%s #%d", projectIDOrKey, repoIDOrName, current.Number),
+	}
+
+	if opts.Title != "" {
+		update.Set("summary", opts.Title)
+		details = append(details, fmt.Sprintf("Title: %s → %s", current.Summary, opts.Title))
+	}
+	if description != "" {
+		update.Set("description", description)
+		details = append(details, fmt.Sprintf("Description: replaced (%d → %d lines)",
+			lineCount(current.Description), lineCount(description)))
+	}
+	if opts.Assignee != "" {
+		user, err := resolveUser(client, projectIDOrKey, opts.Assignee)
+		if err != nil {
+			return err
+		}
+		update.Set("assigneeId", strconv.Itoa(user.ID))
+		from := "(unassigned)"
+		if current.Assignee != nil {
+			from = current.Assignee.Name
+		}
+		details = append(details, fmt.Sprintf("Assignee: %s → %s", from, user.Name))
+	}
+	if opts.Issue != "" {
+		issueData, err := client.GetIssue(opts.Issue)
+		if err != nil {
+			return err
+		}
+		issue, err := backlog.ParseIssue(issueData)
+		if err != nil {
+			return err
+		}
+		update.Set("issueId", strconv.Itoa(issue.ID))
+		from := "(none)"
+		if current.Issue != nil && current.Issue.IssueKey != "" {
+			from = current.Issue.IssueKey
+		}
+		details = append(details, fmt.Sprintf("Issue: %s → %s", from, issue.IssueKey))
+	}
+	if opts.Comment != "" {
+		update.Set("comment", opts.Comment)
+		details = append(details, "Comment: "+opts.Comment)
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		ok, err := prompt.Confirm("Update Pull Request?", "Update", details)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	result, err := client.UpdatePullRequest(projectIDOrKey, repoIDOrName, number, update)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	pr, err := backlog.ParsePullRequest(result)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Pull request updated: #%d %s\n", pr.Number, pr.Summary)
+	return nil
+}
+
+// lineCount returns the number of lines in s, or 0 if it is empty.
+func lineCount(s string) int {
+	if s == "" {
+		return 0
+	}
+	return strings.Count(s, "\n") + 1
+}
@@ -0,0 +1,98 @@
+package pr
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command. Status is open,
+// closed, or merged. Assignee is "me" or a user ID, name, or mail address.
+type ListOptions struct {
+	Raw      bool
+	Status   string
+	Assignee string
+}
+
+// List displays the most recent pull requests of a repository (up to 20,
+// the API default), newest first.
+func List(projectIDOrKey string, repoIDOrName string, opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	query, err := filterQuery(client, projectIDOrKey, opts.Status, opts.Assignee)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetPullRequests(projectIDOrKey, repoIDOrName, query)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	pullRequests, err := backlog.ParsePullRequests(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatPullRequestsMarkdown(repoIDOrName, pullRequests)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// filterQuery builds the pull request list/count query for a status name
+// and an assignee (see resolveUser); either may be empty.
+func filterQuery(client *backlog.Client, projectIDOrKey string, status string, assignee string) (url.Values, error) {
+	query := url.Values{}
+	if status != "" {
+		statusID, err := backlog.PullRequestStatusID(status)
+		if err != nil {
+			return nil, err
+		}
+		query.Set("statusId[]", strconv.Itoa(statusID))
+	}
+	if assignee != "" {
+		user, err := resolveUser(client, projectIDOrKey, assignee)
+		if err != nil {
+			return nil, err
+		}
+		query.Set("assigneeId[]", strconv.Itoa(user.ID))
+	}
+	return query, nil
+}
+
+// resolveUser finds a project member by ID, user ID, name, or mail
+// address, or the logged-in user for "me".
+func resolveUser(client *backlog.Client, projectIDOrKey string, query string) (*backlog.User, error) {
+	if query == "me" {
+		data, err := client.GetMyself()
+		if err != nil {
+			return nil, err
+		}
+		return backlog.ParseUser(data)
+	}
+
+	data, err := client.GetProjectUsers(projectIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	users, err := backlog.ParseUsers(data)
+	if err != nil {
+		return nil, err
+	}
+	return backlog.FindUser(users, query)
+}
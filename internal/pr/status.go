@@ -0,0 +1,135 @@
+package pr
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/git"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// maxPullRequestCount is the largest page size the pull request list API
+// accepts.
+const maxPullRequestCount = 100
+
+// StatusOptions contains options for the status command.
+type StatusOptions struct {
+	Raw bool
+}
+
+// Status shows the open pull request from the current branch of the
+// current directory's clone, with the status of its linked issue.
+func Status(opts StatusOptions) error {
+	remote, err := git.BacklogRemote()
+	if err != nil {
+		return err
+	}
+	branch, err := git.CurrentBranch()
+	if err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	pr, data, err := findOpenPullRequest(client, remote.ProjectKey, remote.Repo, branch)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		if pr == nil {
+			fmt.Println("null")
+			return nil
+		}
+		render.JSON(data)
+		return nil
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s/%s `%s`\n\n", remote.ProjectKey, remote.Repo, branch)
+	if pr == nil {
+		sb.WriteString("No open pull request from this branch.\n")
+		render.Markdown(sb.String())
+		return nil
+	}
+
+	// The issue embedded in a pull request may lack its status
+	issue := pr.Issue
+	if issue != nil && issue.IssueKey != "" && issue.Status == nil {
+		issueData, err := client.GetIssue(issue.IssueKey)
+		if err != nil {
+			return err
+		}
+		if issue, err = backlog.ParseIssue(issueData); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(&sb, "## #%d %s\n", pr.Number, pr.Summary)
+	if pr.Status != nil {
+		fmt.Fprintf(&sb, "- Status: %s\n", pr.Status.Name)
+	}
+	fmt.Fprintf(&sb, "- Base: `%s`\n", pr.Base)
+	if pr.Assignee != nil {
+		fmt.Fprintf(&sb, "- Assignee: %s\n", pr.Assignee.Name)
+	} else {
+		sb.WriteString("- Assignee: (unassigned)\n")
+	}
+	if issue != nil && issue.IssueKey != "" {
+		status := "(unknown)"
+		if issue.Status != nil {
+			status = issue.Status.Name
+		}
+		fmt.Fprintf(&sb, "- Issue: %s %s (%s)\n", issue.IssueKey, issue.Summary, status)
+	} else {
+		sb.WriteString("- Issue: (none)\n")
+	}
+
+	render.Markdown(sb.String())
+	return nil
+}
+
+// findOpenPullRequest finds the open pull request from branch, paging
+// through the repository's open pull requests. It returns nil if there is
+// none, along with the pull request's JSON.
+func findOpenPullRequest(client *backlog.Client, projectIDOrKey string, repoIDOrName string, branch string) (*backlog.PullRequest, []byte, error) {
+	query := url.Values{}
+	query.Set("statusId[]", strconv.Itoa(backlog.PullRequestOpen))
+	query.Set("count", strconv.Itoa(maxPullRequestCount))
+
+	for offset := 0; ; offset += maxPullRequestCount {
+		query.Set("offset", strconv.Itoa(offset))
+		data, err := client.GetPullRequests(projectIDOrKey, repoIDOrName, query)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var page []json.RawMessage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse pull requests: %w", err)
+		}
+		for _, raw := range page {
+			pr, err := backlog.ParsePullRequest(raw)
+			if err != nil {
+				return nil, nil, err
+			}
+			if pr.Branch == branch {
+				return pr, raw, nil
+			}
+		}
+		if len(page) < maxPullRequestCount {
+			return nil, nil, nil
+		}
+	}
+}
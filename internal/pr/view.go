@@ -0,0 +1,49 @@
+package pr
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ViewOptions contains options for the view command.
+type ViewOptions struct {
+	Raw bool
+	Web bool
+}
+
+// View displays a pull request by its number, or opens it in the browser
+// with Web.
+func View(projectIDOrKey string, repoIDOrName string, number string, opts ViewOptions) error {
+	if opts.Web {
+		return Browse(projectIDOrKey, repoIDOrName, number)
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	data, err := client.GetPullRequest(projectIDOrKey, repoIDOrName, number)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	pr, err := backlog.ParsePullRequest(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatPullRequestMarkdown(pr)
+
+	render.Markdown(markdown)
+	return nil
+}
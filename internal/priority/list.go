@@ -0,0 +1,39 @@
+package priority
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw bool
+}
+
+// List displays the priorities of the space.
+func List(opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetPriorities()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	priorities, err := backlog.ParsePriorities(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatPrioritiesMarkdown(priorities)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -0,0 +1,63 @@
+package project
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ActivityOptions contains options for the activity command. Types is a
+// comma-separated list of activity type names, IDs, or groups such as
+// "issue" and "comment".
+type ActivityOptions struct {
+	Raw   bool
+	Limit int
+	Types string
+}
+
+// Activity displays the recent activities of a project, newest first.
+func Activity(projectIDOrKey string, opts ActivityOptions) error {
+	if opts.Limit <= 0 {
+		opts.Limit = 20
+	}
+
+	query := url.Values{}
+	if opts.Types != "" {
+		ids, err := backlog.ParseActivityTypes(opts.Types)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			query.Add("activityTypeId[]", strconv.Itoa(id))
+		}
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := backlog.FetchActivities(opts.Limit, query, func(query url.Values) ([]byte, error) {
+		return client.GetProjectActivities(projectIDOrKey, query)
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	activities, err := backlog.ParseActivities(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatActivitiesMarkdown(activities)
+
+	render.Markdown(markdown)
+	return nil
+}
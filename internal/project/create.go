@@ -0,0 +1,144 @@
+package project
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// Settings holds project settings to set. Nil and empty fields are left
+// unset.
+type Settings struct {
+	Name               string
+	Key                string
+	TextFormattingRule string
+	ChartEnabled       *bool
+	SubtaskingEnabled  *bool
+	UseWiki            *bool
+	UseFileSharing     *bool
+	UseDevAttributes   *bool
+}
+
+// projectKeyPattern matches valid project keys: uppercase letters, digits,
+// and underscores, starting with a letter.
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// values validates the settings and converts them to API parameters.
+func (s Settings) values() (url.Values, error) {
+	data := url.Values{}
+	if s.Name != "" {
+		data.Set("name", s.Name)
+	}
+	if s.Key != "" {
+		if !projectKeyPattern.MatchString(s.Key) {
+			return nil, fmt.Errorf("invalid project key: %s (use uppercase letters, digits, and underscores)", s.Key)
+		}
+		data.Set("key", s.Key)
+	}
+	if s.TextFormattingRule != "" {
+		if s.TextFormattingRule != "markdown" && s.TextFormattingRule != "backlog" {
+			return nil, fmt.Errorf("invalid text formatting rule: %s (expected markdown or backlog)", s.TextFormattingRule)
+		}
+		data.Set("textFormattingRule", s.TextFormattingRule)
+	}
+	for _, flag := range []struct {
+		param string
+		value *bool
+	}{
+		{"chartEnabled", s.ChartEnabled},
+		{"subtaskingEnabled", s.SubtaskingEnabled},
+		{"useWiki", s.UseWiki},
+		{"useFileSharing", s.UseFileSharing},
+		{"useDevAttributes", s.UseDevAttributes},
+	} {
+		if flag.value != nil {
+			data.Set(flag.param, strconv.FormatBool(*flag.value))
+		}
+	}
+	return data, nil
+}
+
+// CreateOptions contains options for the create command.
+type CreateOptions struct {
+	Raw bool
+	Yes bool
+	Settings
+}
+
+// Create creates a project. Charts and subtasking default to off and the
+// text formatting rule to markdown, as the API requires them.
+func Create(opts CreateOptions) error {
+	data, err := opts.Settings.values()
+	if err != nil {
+		return err
+	}
+	if !data.Has("name") || !data.Has("key") {
+		return fmt.Errorf("project name and key are required")
+	}
+	for param, value := range map[string]string{
+		"chartEnabled":       "false",
+		"subtaskingEnabled":  "false",
+		"textFormattingRule": "markdown",
+	} {
+		if !data.Has(param) {
+			data.Set(param, value)
+		}
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		ok, err := confirmSettings("Create Project?", "Create", client.GetSpace(), data)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	result, err := client.AddProject(data)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	project, err := backlog.ParseProject(result)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Project created: %s (%s)\n", project.Name, project.ProjectKey)
+	return nil
+}
+
+// confirmSettings asks for confirmation, listing the settings to apply.
+func confirmSettings(title string, affirmative string, space string, data url.Values) (bool, error) {
+	lines := []string{"Space: " + space}
+	for _, param := range []string{"name", "key", "textFormattingRule", "chartEnabled", "subtaskingEnabled",
+		"useWiki", "useFileSharing", "useDevAttributes", "archived"} {
+		if data.Has(param) {
+			lines = append(lines, param+": "+data.Get(param))
+		}
+	}
+
+	confirm, err := prompt.Confirm(title, affirmative, lines)
+	if err != nil {
+		return false, err
+	}
+	return confirm, nil
+}
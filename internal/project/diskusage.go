@@ -0,0 +1,39 @@
+package project
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// DiskUsageOptions contains options for the disk-usage command.
+type DiskUsageOptions struct {
+	Raw bool
+}
+
+// DiskUsage displays how much disk space a project uses, by feature.
+func DiskUsage(projectIDOrKey string, opts DiskUsageOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetProjectDiskUsage(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	usage, err := backlog.ParseProjectDiskUsage(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatProjectDiskUsageMarkdown(projectIDOrKey, usage)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -0,0 +1,67 @@
+package project
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// EditOptions contains options for the edit command. Archived is applied
+// only if non-nil.
+type EditOptions struct {
+	Raw      bool
+	Yes      bool
+	Archived *bool
+	Settings
+}
+
+// Edit updates a project's name, key, settings, or archived flag.
+func Edit(projectIDOrKey string, opts EditOptions) error {
+	data, err := opts.Settings.values()
+	if err != nil {
+		return err
+	}
+	if opts.Archived != nil {
+		data.Set("archived", strconv.FormatBool(*opts.Archived))
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("nothing to update")
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		ok, err := confirmSettings("Update Project "+projectIDOrKey+"?", "Update", client.GetSpace(), data)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	result, err := client.UpdateProject(projectIDOrKey, data)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	project, err := backlog.ParseProject(result)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Project updated: %s (%s)\n", project.Name, project.ProjectKey)
+	return nil
+}
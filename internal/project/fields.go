@@ -0,0 +1,43 @@
+package project
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// FieldsOptions contains options for the fields command.
+type FieldsOptions struct {
+	Raw bool
+}
+
+// Fields displays the custom fields of a project.
+func Fields(projectIDOrKey string, opts FieldsOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityCustomFields); err != nil {
+		return err
+	}
+
+	data, err := client.GetCustomFields(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fields, err := backlog.ParseCustomFields(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatCustomFieldsMarkdown(fields)
+
+	render.Markdown(markdown)
+	return nil
+}
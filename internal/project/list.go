@@ -0,0 +1,46 @@
+package project
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw bool
+}
+
+// List displays the projects the user can access.
+func List(opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetProjects()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	projects, err := backlog.ParseProjects(data)
+	if err != nil {
+		return err
+	}
+
+	if len(projects) == 0 {
+		fmt.Println("No projects found.")
+		return nil
+	}
+
+	markdown := backlog.FormatProjectsMarkdown(projects)
+
+	render.Markdown(markdown)
+	return nil
+}
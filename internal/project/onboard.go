@@ -0,0 +1,181 @@
+package project
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/config"
+	"github.com/dannygim/bgl/internal/prompt"
+)
+
+// defaultWelcome is the welcome comment used when none is configured.
+const defaultWelcome = "Welcome to the project, {name}! This issue walks you through getting started."
+
+// OnboardOptions contains options for the onboard command.
+type OnboardOptions struct {
+	Yes           bool
+	User          string
+	TemplateIssue string
+}
+
+// Onboard adds a user to a project, creates an onboarding issue for them
+// from a template issue, and posts a welcome comment with links.
+func Onboard(projectKey string, opts OnboardOptions) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	templateKey := opts.TemplateIssue
+	welcome := defaultWelcome
+	if cfg.Onboarding != nil {
+		if templateKey == "" {
+			templateKey = cfg.Onboarding.TemplateIssue[projectKey]
+		}
+		if cfg.Onboarding.Welcome != "" {
+			welcome = cfg.Onboarding.Welcome
+		}
+	}
+	if templateKey == "" {
+		return fmt.Errorf("no onboarding template issue for %s (use --template or set onboarding.template_issue in the config)", projectKey)
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetProject(projectKey)
+	if err != nil {
+		return err
+	}
+	project, err := backlog.ParseProject(data)
+	if err != nil {
+		return err
+	}
+
+	// Listing space users requires administrator rights
+	data, err = client.GetUsers()
+	if err != nil {
+		return fmt.Errorf("failed to list users (administrator rights are required): %w", err)
+	}
+	users, err := backlog.ParseUsers(data)
+	if err != nil {
+		return err
+	}
+	user, err := backlog.FindUser(users, opts.User)
+	if err != nil {
+		return err
+	}
+
+	data, err = client.GetProjectUsers(project.ProjectKey)
+	if err != nil {
+		return err
+	}
+	members, err := backlog.ParseUsers(data)
+	if err != nil {
+		return err
+	}
+	isMember := false
+	for _, member := range members {
+		if member.ID == user.ID {
+			isMember = true
+			break
+		}
+	}
+
+	data, err = client.GetIssue(templateKey)
+	if err != nil {
+		return fmt.Errorf("failed to get template issue %s: %w", templateKey, err)
+	}
+	template, err := backlog.ParseIssue(data)
+	if err != nil {
+		return err
+	}
+	if template.IssueType == nil || template.Priority == nil {
+		return fmt.Errorf("template issue %s has no issue type or priority", templateKey)
+	}
+
+	summary := fmt.Sprintf("%s: %s", template.Summary, user.Name)
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		var steps strings.Builder
+		if isMember {
+			fmt.Fprintf(&steps, "1. (already a member of %s)\n", project.ProjectKey)
+		} else {
+			fmt.Fprintf(&steps, "1. Add %s to %s\n", user.Name, project.ProjectKey)
+		}
+		fmt.Fprintf(&steps, "2. Create \"%s\" from %s, assigned to %s\n", summary, templateKey, user.Name)
+		fmt.Fprintf(&steps, "3. Post a welcome comment notifying %s", user.Name)
+
+		confirm, err := prompt.Confirm("Onboard User?", "Confirm", []string{
+			"Space: " + client.GetSpace(),
+			"Project: " + project.ProjectKey,
+			fmt.Sprintf("User: %s <%s>", user.Name, user.MailAddress),
+			"",
+			steps.String(),
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	if !isMember {
+		if _, err := client.AddProjectUser(project.ProjectKey, user.ID); err != nil {
+			return fmt.Errorf("failed to add %s to %s: %w", user.Name, project.ProjectKey, err)
+		}
+		fmt.Printf("Added %s to %s\n", user.Name, project.ProjectKey)
+	}
+
+	issueData := url.Values{}
+	issueData.Set("projectId", strconv.Itoa(project.ID))
+	issueData.Set("summary", summary)
+	issueData.Set("issueTypeId", strconv.Itoa(template.IssueType.ID))
+	issueData.Set("priorityId", strconv.Itoa(template.Priority.ID))
+	issueData.Set("assigneeId", strconv.Itoa(user.ID))
+	if template.Description != "" {
+		issueData.Set("description", template.Description)
+	}
+	data, err = client.AddIssue(issueData)
+	if err != nil {
+		return fmt.Errorf("failed to create onboarding issue: %w", err)
+	}
+	created, err := backlog.ParseIssue(data)
+	if err != nil {
+		return err
+	}
+
+	space := client.GetSpace()
+	issueURL := fmt.Sprintf("https://%s/view/%s", space, created.IssueKey)
+	fmt.Printf("Created %s: %s\n", created.IssueKey, issueURL)
+
+	var comment strings.Builder
+	comment.WriteString(strings.ReplaceAll(welcome, "{name}", user.Name))
+	comment.WriteString("\n\n")
+	fmt.Fprintf(&comment, "- Project: https://%s/projects/%s\n", space, project.ProjectKey)
+	if project.UseWiki {
+		fmt.Fprintf(&comment, "- Wiki: https://%s/wiki/%s/Home\n", space, project.ProjectKey)
+	}
+	fmt.Fprintf(&comment, "- Your onboarding issue: %s", issueURL)
+
+	data, err = client.AddComment(created.IssueKey, comment.String(), []string{strconv.Itoa(user.ID)})
+	if err != nil {
+		return fmt.Errorf("failed to post welcome comment: %w", err)
+	}
+	posted, err := backlog.ParseComment(data)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Posted welcome comment: %s\n", backlog.CommentURL(space, created.IssueKey, posted.ID))
+
+	return nil
+}
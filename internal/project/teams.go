@@ -0,0 +1,39 @@
+package project
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// TeamsOptions contains options for the teams command.
+type TeamsOptions struct {
+	Raw bool
+}
+
+// Teams displays the teams attached to a project.
+func Teams(projectIDOrKey string, opts TeamsOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetProjectTeams(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	teams, err := backlog.ParseTeams(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatTeamsMarkdown(teams)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -0,0 +1,134 @@
+package project
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// UserOptions contains options for the user add and remove commands.
+type UserOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// UserAdd adds a space user, given by mail address, name, user ID, or
+// numeric ID, to a project. Adding an existing member is not an error, so
+// scripts can run it repeatedly.
+func UserAdd(projectIDOrKey string, query string, opts UserOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// Listing space users requires administrator rights
+	data, err := client.GetUsers()
+	if err != nil {
+		return fmt.Errorf("failed to list users (administrator rights are required): %w", err)
+	}
+	users, err := backlog.ParseUsers(data)
+	if err != nil {
+		return err
+	}
+	user, err := backlog.FindUser(users, query)
+	if err != nil {
+		return err
+	}
+
+	members, err := projectMembers(client, projectIDOrKey)
+	if err != nil {
+		return err
+	}
+	if _, err := backlog.FindUser(members, strconv.Itoa(user.ID)); err == nil {
+		fmt.Printf("%s is already a member of %s.\n", user.Name, projectIDOrKey)
+		return nil
+	}
+
+	ok, err := confirmUser(opts, "Add User to Project?", "Add", client.GetSpace(), projectIDOrKey, user)
+	if err != nil || !ok {
+		return err
+	}
+
+	data, err = client.AddProjectUser(projectIDOrKey, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fmt.Printf("Added %s to %s.\n", user.Name, projectIDOrKey)
+	return nil
+}
+
+// UserRemove removes a member, given by mail address, name, user ID, or
+// numeric ID, from a project.
+func UserRemove(projectIDOrKey string, query string, opts UserOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	members, err := projectMembers(client, projectIDOrKey)
+	if err != nil {
+		return err
+	}
+	user, err := backlog.FindUser(members, query)
+	if err != nil {
+		return fmt.Errorf("%w (not a member of %s)", err, projectIDOrKey)
+	}
+
+	ok, err := confirmUser(opts, "Remove User from Project?", "Remove", client.GetSpace(), projectIDOrKey, user)
+	if err != nil || !ok {
+		return err
+	}
+
+	data, err := client.DeleteProjectUser(projectIDOrKey, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fmt.Printf("Removed %s from %s.\n", user.Name, projectIDOrKey)
+	return nil
+}
+
+// projectMembers lists the members of a project.
+func projectMembers(client *backlog.Client, projectIDOrKey string) ([]backlog.User, error) {
+	data, err := client.GetProjectUsers(projectIDOrKey)
+	if err != nil {
+		return nil, err
+	}
+	return backlog.ParseUsers(data)
+}
+
+// confirmUser shows a confirmation for a membership change unless --yes is
+// specified. It prints "Cancelled." and returns false if declined.
+func confirmUser(opts UserOptions, title string, affirmative string, space string, projectIDOrKey string, user *backlog.User) (bool, error) {
+	if opts.Yes {
+		return true, nil
+	}
+
+	confirm, err := prompt.Confirm(title, affirmative, []string{
+		"Space: " + space,
+		"Project: " + projectIDOrKey,
+		fmt.Sprintf("User: %s <%s>", user.Name, user.MailAddress),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if !confirm {
+		fmt.Println("Cancelled.")
+	}
+	return confirm, nil
+}
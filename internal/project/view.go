@@ -0,0 +1,39 @@
+package project
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ViewOptions contains options for the view command.
+type ViewOptions struct {
+	Raw bool
+}
+
+// View displays a project's settings.
+func View(projectIDOrKey string, opts ViewOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetProject(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	project, err := backlog.ParseProject(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatProjectMarkdown(project)
+
+	render.Markdown(markdown)
+	return nil
+}
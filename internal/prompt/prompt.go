@@ -0,0 +1,23 @@
+package prompt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+// Confirm asks for confirmation, showing details one per line.
+func Confirm(title string, affirmative string, details []string) (bool, error) {
+	var ok bool
+	if err := huh.NewConfirm().
+		Title(title).
+		Description(strings.Join(details, "\n")).
+		Affirmative(affirmative).
+		Negative("Cancel").
+		Value(&ok).
+		Run(); err != nil {
+		return false, fmt.Errorf("confirmation failed: %w", err)
+	}
+	return ok, nil
+}
@@ -0,0 +1,209 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/config"
+	"github.com/dannygim/bgl/internal/locale"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// queueFileName is the name of the pending comment queue file.
+const queueFileName = "queue.json"
+
+// Item is a comment waiting to be posted.
+type Item struct {
+	IssueKey        string    `json:"issue_key"`
+	Content         string    `json:"content"`
+	NotifiedUserIDs []string  `json:"notified_user_ids,omitempty"`
+	QueuedAt        time.Time `json:"queued_at"`
+}
+
+// IsConnectivityError reports whether err is a network failure (as opposed
+// to an API error response), meaning the request may succeed later.
+func IsConnectivityError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+func getQueuePath() (string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, queueFileName), nil
+}
+
+func load() ([]Item, error) {
+	path, err := getQueuePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var items []Item
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("failed to parse queue: %w", err)
+	}
+	return items, nil
+}
+
+func save(items []Item) error {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		return err
+	}
+
+	path, err := getQueuePath()
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Add appends a comment to the queue.
+func Add(issueKey string, content string, notifiedUserIDs []string) error {
+	items, err := load()
+	if err != nil {
+		return err
+	}
+	items = append(items, Item{IssueKey: issueKey, Content: content, NotifiedUserIDs: notifiedUserIDs, QueuedAt: time.Now()})
+	return save(items)
+}
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw bool
+}
+
+// List displays the pending comments.
+func List(opts ListOptions) error {
+	items, err := load()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		if items == nil {
+			items = []Item{}
+		}
+		formatted, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+		return nil
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No queued comments.")
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("## Queued Comment\n")
+	for i, item := range items {
+		firstLine, _, _ := strings.Cut(item.Content, "\n")
+		fmt.Fprintf(&sb, "%d. %s: %s (queued: %s)\n", i+1, item.IssueKey, firstLine, locale.DateTime(item.QueuedAt))
+	}
+	markdown := sb.String()
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// Flush posts the queued comments in order. Each posted comment notes when
+// it was originally written. It stops at the first failure or on Ctrl-C,
+// keeping the remaining comments queued.
+func Flush() error {
+	items, err := load()
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No queued comments.")
+		return nil
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// On Ctrl-C, finish the comment being posted and stop. The queue file
+	// is saved after every post, so it is always the resume point.
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+
+	total := len(items)
+	for len(items) > 0 {
+		select {
+		case <-interrupted:
+			fmt.Printf("Interrupted after posting %d of %d comment(s). %d comment(s) still queued; run 'bgl queue flush' to resume.\n",
+				total-len(items), total, len(items))
+			return nil
+		default:
+		}
+
+		item := items[0]
+		content := fmt.Sprintf("%s\n\n(Written offline at %s, posted at %s)",
+			item.Content, item.QueuedAt.Format(time.RFC3339), time.Now().Format(time.RFC3339))
+
+		data, err := client.AddComment(item.IssueKey, content, item.NotifiedUserIDs)
+		if err != nil {
+			if saveErr := save(items); saveErr != nil {
+				return fmt.Errorf("failed to save queue: %w", saveErr)
+			}
+			return fmt.Errorf("failed to post comment to %s (%d comment(s) still queued): %w", item.IssueKey, len(items), err)
+		}
+
+		// Dequeue as soon as the comment is posted so it is never posted twice
+		items = items[1:]
+		if err := save(items); err != nil {
+			return fmt.Errorf("failed to save queue: %w", err)
+		}
+
+		comment, err := backlog.ParseComment(data)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: posted a comment to %s but could not read the response: %v\n", item.IssueKey, err)
+			continue
+		}
+		fmt.Printf("Posted: %s\n", backlog.CommentURL(client.GetSpace(), item.IssueKey, comment.ID))
+	}
+
+	fmt.Println("All queued comments posted.")
+	return nil
+}
@@ -0,0 +1,39 @@
+package ratelimit
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// Options contains options for the ratelimit command.
+type Options struct {
+	Raw bool
+}
+
+// Show displays the remaining API quotas and when they reset.
+func Show(opts Options) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetRateLimit()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	limits, err := backlog.ParseRateLimits(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatRateLimitsMarkdown(limits)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -0,0 +1,22 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// JSON pretty prints a JSON response, falling back to the raw response if
+// it cannot be parsed.
+func JSON(data []byte) {
+	var prettyJSON any
+	if err := json.Unmarshal(data, &prettyJSON); err != nil {
+		fmt.Println(string(data))
+		return
+	}
+	formatted, err := json.MarshalIndent(prettyJSON, "", "  ")
+	if err != nil {
+		fmt.Println(string(data))
+		return
+	}
+	fmt.Println(string(formatted))
+}
@@ -0,0 +1,203 @@
+package render
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/dannygim/bgl/internal/config"
+	"golang.org/x/term"
+)
+
+// Render modes.
+const (
+	Auto   = "auto"
+	Always = "always"
+	Never  = "never"
+)
+
+// mode is the render mode set by the global --render flag.
+var mode = Auto
+
+// Layout widths. Output is wrapped at maxWidth columns; terminals narrower
+// than narrowWidth get the stacked layout.
+const (
+	maxWidth    = 100
+	narrowWidth = 60
+)
+
+// SetMode sets the render mode: always, never, or auto (render only when
+// stdout is a terminal).
+func SetMode(m string) error {
+	switch m {
+	case Auto, Always, Never:
+		mode = m
+		return nil
+	}
+	return fmt.Errorf("invalid --render value %q (expected always, never, or auto)", m)
+}
+
+// Enabled reports whether output should be styled with ANSI escapes.
+func Enabled() bool {
+	switch mode {
+	case Always:
+		return true
+	case Never:
+		return false
+	}
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Markdown prints Markdown to stdout, rendered for the terminal when
+// enabled, or as plain Markdown when output is piped or rendering fails.
+// Output taller than the terminal goes through the pager.
+func Markdown(markdown string) {
+	if !Enabled() {
+		page(markdown)
+		return
+	}
+
+	wrap := maxWidth
+	if width := Width(); width > 0 && width < narrowWidth {
+		// Tables cannot shrink below their content, so stack them instead
+		markdown = stackTables(markdown)
+		wrap = width
+	}
+
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithAutoStyle(),
+		glamour.WithWordWrap(wrap),
+	)
+	if err != nil {
+		page(markdown)
+		return
+	}
+
+	rendered, err := renderer.Render(markdown)
+	if err != nil {
+		page(markdown)
+		return
+	}
+
+	page(rendered)
+}
+
+// pagerCommand returns the pager command: the one set by 'bgl init', then
+// $PAGER. It returns "" if neither is set.
+func pagerCommand() string {
+	if cfg, err := config.Load(); err == nil && strings.TrimSpace(cfg.Pager) != "" {
+		return cfg.Pager
+	}
+	return os.Getenv("PAGER")
+}
+
+// page prints s to stdout, through the pager when stdout is a terminal and
+// s does not fit on one screen. If the pager cannot be started, s is
+// printed directly.
+func page(s string) {
+	parts := strings.Fields(pagerCommand())
+	if len(parts) == 0 || !term.IsTerminal(int(os.Stdout.Fd())) {
+		fmt.Print(s)
+		return
+	}
+	_, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || strings.Count(s, "\n") < height {
+		fmt.Print(s)
+		return
+	}
+
+	cmd := exec.Command(parts[0], parts[1:]...)
+	cmd.Stdin = strings.NewReader(s)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if os.Getenv("LESS") == "" {
+		// Pass ANSI colors through and quit if the output fits, like git
+		cmd.Env = append(os.Environ(), "LESS=FRX")
+	}
+	if err := cmd.Start(); err != nil {
+		fmt.Print(s)
+		return
+	}
+	_ = cmd.Wait()
+}
+
+// Width returns the terminal width in columns, from $COLUMNS if set or
+// from stdout otherwise. It returns 0 if the width is unknown.
+func Width() int {
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// stackTables rewrites each Markdown table as one block per row, with a
+// "**Header:** value" line per cell, so that rows read vertically.
+func stackTables(markdown string) string {
+	lines := strings.Split(markdown, "\n")
+	var out []string
+	for i := 0; i < len(lines); i++ {
+		if i+1 >= len(lines) || !isTableRow(lines[i]) || !isDelimiterRow(lines[i+1]) {
+			out = append(out, lines[i])
+			continue
+		}
+
+		headers := tableCells(lines[i])
+		i += 2
+		for ; i < len(lines) && isTableRow(lines[i]); i++ {
+			for j, cell := range tableCells(lines[i]) {
+				if j < len(headers) && headers[j] != "" {
+					// Trailing double space keeps each cell on its own line
+					out = append(out, fmt.Sprintf("**%s:** %s  ", headers[j], cell))
+				} else {
+					out = append(out, cell+"  ")
+				}
+			}
+			out = append(out, "")
+		}
+		i--
+	}
+	return strings.Join(out, "\n")
+}
+
+func isTableRow(line string) bool {
+	line = strings.TrimSpace(line)
+	return len(line) >= 2 && strings.HasPrefix(line, "|") && strings.HasSuffix(line, "|")
+}
+
+func isDelimiterRow(line string) bool {
+	return isTableRow(line) && strings.Trim(strings.TrimSpace(line), "|-: ") == ""
+}
+
+// tableCells splits a table row into its trimmed cells, keeping escaped
+// pipes inside cells.
+func tableCells(line string) []string {
+	line = strings.TrimSpace(line)
+	line = line[1 : len(line)-1]
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' && i+1 < len(line) && line[i+1] == '|' {
+			cell.WriteString("\\|")
+			i++
+			continue
+		}
+		if line[i] == '|' {
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+			continue
+		}
+		cell.WriteByte(line[i])
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
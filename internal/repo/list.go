@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw bool
+}
+
+// List displays the Git repositories of a project with their clone URLs.
+func List(projectIDOrKey string, opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	data, err := client.GetGitRepositories(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	repositories, err := backlog.ParseRepositories(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatRepositoriesMarkdown(repositories)
+
+	render.Markdown(markdown)
+	return nil
+}
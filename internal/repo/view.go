@@ -0,0 +1,100 @@
+package repo
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/config"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// Clone protocols.
+const (
+	ProtocolSSH   = "ssh"
+	ProtocolHTTPS = "https"
+)
+
+// ViewOptions contains options for the view command. With Clone, the
+// repository is cloned into the current directory with git. Protocol picks
+// the clone URL, overriding the git_protocol config setting.
+type ViewOptions struct {
+	Raw      bool
+	Clone    bool
+	Protocol string
+}
+
+// View displays a Git repository's details, or clones it.
+func View(projectIDOrKey string, repoIDOrName string, opts ViewOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityGit); err != nil {
+		return err
+	}
+
+	data, err := client.GetGitRepository(projectIDOrKey, repoIDOrName)
+	if err != nil {
+		return err
+	}
+
+	if opts.Clone {
+		repository, err := backlog.ParseRepository(data)
+		if err != nil {
+			return err
+		}
+		return clone(repository, opts.Protocol)
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	repository, err := backlog.ParseRepository(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatRepositoryMarkdown(repository)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// clone runs git clone for a repository. Authentication is left to git,
+// so the user's SSH keys or HTTPS credential helper are used.
+func clone(repository *backlog.Repository, protocol string) error {
+	if protocol == "" {
+		if cfg, err := config.Load(); err == nil {
+			protocol = cfg.GitProtocol
+		}
+	}
+
+	var cloneURL string
+	switch protocol {
+	case "", ProtocolSSH:
+		cloneURL = repository.SSHURL
+	case ProtocolHTTPS:
+		cloneURL = repository.HTTPURL
+	default:
+		return fmt.Errorf("invalid git protocol %q (expected ssh or https)", protocol)
+	}
+
+	if _, err := exec.LookPath("git"); err != nil {
+		return fmt.Errorf("git not found in PATH")
+	}
+
+	fmt.Printf("Cloning %s\n", cloneURL)
+	cmd := exec.Command("git", "clone", cloneURL)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git clone failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package resolution
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw bool
+}
+
+// List displays the resolutions of the space.
+func List(opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetResolutions()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	resolutions, err := backlog.ParseResolutions(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatResolutionsMarkdown(resolutions)
+
+	render.Markdown(markdown)
+	return nil
+}
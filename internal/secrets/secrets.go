@@ -0,0 +1,127 @@
+package secrets
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dannygim/bgl/internal/config"
+)
+
+// rule is a named pattern for a kind of secret.
+type rule struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+var rules = []rule{
+	{"private key", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
+	{"AWS access key", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"GitHub token", regexp.MustCompile(`\b(ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{36}\b|\bgithub_pat_[A-Za-z0-9_]{22,}\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}\b`)},
+	{"Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{"JWT", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\b`)},
+	{"API key assignment", regexp.MustCompile(`(?i)\b(api[_-]?key|secret|access[_-]?token|password)\s*[:=]\s*["']?[A-Za-z0-9/+_.-]{16,}`)},
+}
+
+// Finding is a secret found in text.
+type Finding struct {
+	Rule  string
+	Match string
+}
+
+// Scan returns the secrets found in text, skipping matches that contain
+// an allowlisted string.
+func Scan(text string, allowlist []string) []Finding {
+	var findings []Finding
+	for _, r := range rules {
+	matches:
+		for _, match := range r.pattern.FindAllString(text, -1) {
+			for _, allowed := range allowlist {
+				if allowed != "" && strings.Contains(match, allowed) {
+					continue matches
+				}
+			}
+			findings = append(findings, Finding{Rule: r.name, Match: redact(match)})
+		}
+	}
+	return findings
+}
+
+// redact shortens a match so the secret itself is not echoed back.
+func redact(s string) string {
+	if len(s) <= 8 {
+		return s
+	}
+	return s[:8] + "…"
+}
+
+// Check scans outgoing text for secrets according to the configured mode:
+// "block" (default) returns an error, "warn" prints a warning to stderr,
+// and "off" skips scanning.
+func Check(texts ...string) error {
+	return check("", texts)
+}
+
+// CheckFiles scans files about to be uploaded like Check. Binary files,
+// detected by NUL bytes or invalid UTF-8, are not scanned.
+func CheckFiles(paths ...string) error {
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+		if bytes.IndexByte(data, 0) >= 0 || !utf8.Valid(data) {
+			continue
+		}
+		if err := check(path+": ", []string{string(data)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check implements Check, prefixing the report with label.
+func check(label string, texts []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	mode := "block"
+	var allowlist []string
+	if cfg.Secrets != nil {
+		if cfg.Secrets.Mode != "" {
+			mode = cfg.Secrets.Mode
+		}
+		allowlist = cfg.Secrets.Allowlist
+	}
+	if mode == "off" {
+		return nil
+	}
+
+	var findings []Finding
+	for _, text := range texts {
+		findings = append(findings, Scan(text, allowlist)...)
+	}
+	if len(findings) == 0 {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(label + "possible secrets found:")
+	for _, f := range findings {
+		fmt.Fprintf(&sb, "\n  - %s: %s", f.Rule, f.Match)
+	}
+
+	if mode == "warn" {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", sb.String())
+		return nil
+	}
+
+	sb.WriteString("\nRemove them, or add an allowlist entry to \"secrets\" in the config file")
+	return fmt.Errorf("%s", sb.String())
+}
@@ -0,0 +1,150 @@
+package setup
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/huh"
+	"github.com/dannygim/bgl/internal/auth"
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/config"
+)
+
+// Init runs the interactive first-run setup: login with OAuth or an API key,
+// connectivity check, and default project and editor/pager preferences.
+func Init() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	// Ask before overwriting an existing login
+	if cfg.AccessToken != "" || cfg.APIKey != "" {
+		var again bool
+		if err := huh.NewConfirm().
+			Title("Already configured").
+			Description(fmt.Sprintf("Space: %s\nRun setup again?", cfg.Space)).
+			Affirmative("Yes").
+			Negative("No").
+			Value(&again).
+			Run(); err != nil {
+			return fmt.Errorf("confirmation failed: %w", err)
+		}
+
+		if !again {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	method := "oauth"
+	if err := huh.NewSelect[string]().
+		Title("Authentication").
+		Description("How bgl should authenticate with Backlog").
+		Options(
+			huh.NewOption("OAuth 2.0 (browser login)", "oauth"),
+			huh.NewOption("API key (Personal Settings > API)", "apikey"),
+		).
+		Value(&method).
+		Run(); err != nil {
+		return fmt.Errorf("failed to select authentication method: %w", err)
+	}
+
+	space := cfg.Space
+	if err := huh.NewInput().
+		Title("Space").
+		Description("Enter your Backlog space (e.g. myspace.backlog.com)").
+		Placeholder("myspace.backlog.com").
+		Validate(auth.ValidateSpace).
+		Value(&space).
+		Run(); err != nil {
+		return fmt.Errorf("failed to get space input: %w", err)
+	}
+
+	login := auth.LoginSpace
+	if method == "apikey" {
+		login = auth.LoginSpaceWithAPIKey
+	}
+	if err := login(space); err != nil {
+		return err
+	}
+
+	// Test connectivity with the new credentials
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+	data, err := client.GetMyself()
+	if err != nil {
+		return fmt.Errorf("connectivity check failed: %w", err)
+	}
+	user, err := backlog.ParseUser(data)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Connected to %s as %s <%s>\n\n", space, user.Name, user.MailAddress)
+
+	data, err = client.GetProjects()
+	if err != nil {
+		return err
+	}
+	projects, err := backlog.ParseProjects(data)
+	if err != nil {
+		return err
+	}
+
+	// Reload config to keep the credentials saved by the login
+	cfg, err = config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	defaultProject := cfg.DefaultProject
+	if len(projects) > 0 {
+		options := make([]huh.Option[string], 0, len(projects)+1)
+		options = append(options, huh.NewOption("(none)", ""))
+		for _, project := range projects {
+			options = append(options, huh.NewOption(fmt.Sprintf("%s (%s)", project.Name, project.ProjectKey), project.ProjectKey))
+		}
+		if err := huh.NewSelect[string]().
+			Title("Default Project").
+			Description("Used when a command's project is omitted").
+			Options(options...).
+			Value(&defaultProject).
+			Run(); err != nil {
+			return fmt.Errorf("failed to select default project: %w", err)
+		}
+	}
+
+	editor := cfg.Editor
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	pager := cfg.Pager
+	if pager == "" {
+		pager = os.Getenv("PAGER")
+	}
+	if err := huh.NewForm(huh.NewGroup(
+		huh.NewInput().
+			Title("Editor").
+			Description("Command used to compose text (empty to use $EDITOR)").
+			Value(&editor),
+		huh.NewInput().
+			Title("Pager").
+			Description("Command used to page long output (empty to use $PAGER)").
+			Value(&pager),
+	)).Run(); err != nil {
+		return fmt.Errorf("failed to get preferences: %w", err)
+	}
+
+	cfg.DefaultProject = defaultProject
+	cfg.Editor = editor
+	cfg.Pager = pager
+
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Println("Setup complete!")
+	return nil
+}
@@ -0,0 +1,119 @@
+package setup
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/config"
+	"github.com/dannygim/bgl/internal/locale"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// StatusOptions contains options for the auth status command.
+type StatusOptions struct {
+	Raw bool
+}
+
+// authStatus is the result of checking the stored login.
+type authStatus struct {
+	Space     string `json:"space"`
+	User      string `json:"user,omitempty"`
+	ExpiresAt string `json:"expiresAt,omitempty"`
+	Refreshed bool   `json:"refreshed"`
+	OK        bool   `json:"ok"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Status verifies the stored login by calling the API, which refreshes an
+// expired access token when possible, and reports the space, user, and
+// token expiry. It returns an error if the login is not usable.
+func Status(opts StatusOptions) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg.AccessToken == "" && cfg.APIKey == "" {
+		return fmt.Errorf("not logged in. Please run 'bgl auth login'")
+	}
+
+	status := authStatus{Space: cfg.Space}
+	checkErr := checkLogin(&status, cfg.ExpiresAt)
+
+	if opts.Raw {
+		formatted, err := json.MarshalIndent(status, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+	} else {
+		render.Markdown(formatStatusMarkdown(&status))
+	}
+
+	if checkErr != nil {
+		return fmt.Errorf("login for %s is not usable", status.Space)
+	}
+	return nil
+}
+
+// checkLogin fills in status by fetching the current user. expiresAt is the
+// token expiry before the check, used to detect a refresh.
+func checkLogin(status *authStatus, expiresAt int64) error {
+	fail := func(err error) error {
+		status.Error = err.Error()
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return fail(err)
+	}
+	data, err := client.GetMyself()
+	if err != nil {
+		return fail(err)
+	}
+	user, err := backlog.ParseUser(data)
+	if err != nil {
+		return fail(err)
+	}
+	status.User = fmt.Sprintf("%s <%s>", user.Name, user.MailAddress)
+	status.OK = true
+
+	// Reload config to see a token refreshed by the request
+	cfg, err := config.Load()
+	if err != nil {
+		return fail(fmt.Errorf("failed to load config: %w", err))
+	}
+	status.Refreshed = cfg.ExpiresAt != expiresAt
+	if cfg.ExpiresAt > 0 {
+		status.ExpiresAt = time.UnixMilli(cfg.ExpiresAt).Format(time.RFC3339)
+	}
+	return nil
+}
+
+// formatStatusMarkdown formats the login status as Markdown.
+func formatStatusMarkdown(status *authStatus) string {
+	var sb strings.Builder
+
+	sb.WriteString("## Authentication\n")
+	fmt.Fprintf(&sb, "- Space: %s\n", status.Space)
+	if status.User != "" {
+		fmt.Fprintf(&sb, "- User: %s\n", status.User)
+	}
+	if status.ExpiresAt != "" {
+		expires := locale.DateTimeString(status.ExpiresAt)
+		if status.Refreshed {
+			expires += " (refreshed)"
+		}
+		fmt.Fprintf(&sb, "- Token expires: %s\n", expires)
+	}
+	if status.OK {
+		sb.WriteString("- Status: OK\n")
+	} else {
+		fmt.Fprintf(&sb, "- Status: %s\n", status.Error)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,62 @@
+package space
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ActivityOptions contains options for the activity command. Types is a
+// comma-separated list of activity type names, IDs, or groups such as
+// "issue" and "wiki".
+type ActivityOptions struct {
+	Raw   bool
+	Limit int
+	Types string
+}
+
+// Activity displays the recent activities across all projects of the
+// space, newest first.
+func Activity(opts ActivityOptions) error {
+	if opts.Limit <= 0 {
+		opts.Limit = 20
+	}
+
+	query := url.Values{}
+	if opts.Types != "" {
+		ids, err := backlog.ParseActivityTypes(opts.Types)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			query.Add("activityTypeId[]", strconv.Itoa(id))
+		}
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := backlog.FetchActivities(opts.Limit, query, client.GetSpaceActivities)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	activities, err := backlog.ParseActivities(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatCrossProjectActivitiesMarkdown(activities)
+
+	render.Markdown(markdown)
+	return nil
+}
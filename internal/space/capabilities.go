@@ -0,0 +1,61 @@
+package space
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/locale"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// CapabilitiesOptions contains options for the capabilities command.
+type CapabilitiesOptions struct {
+	Raw     bool
+	Refresh bool
+}
+
+// Capabilities displays which plan features the space has.
+func Capabilities(opts CapabilitiesOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	capabilities, err := client.Capabilities(opts.Refresh)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		formatted, err := json.MarshalIndent(capabilities, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+		return nil
+	}
+
+	features := make([]string, 0, len(capabilities.Features))
+	for feature := range capabilities.Features {
+		features = append(features, feature)
+	}
+	sort.Strings(features)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## Capabilities (%s)\n", capabilities.Space)
+	for _, feature := range features {
+		available := "no"
+		if capabilities.Features[feature] {
+			available = "yes"
+		}
+		fmt.Fprintf(&sb, "- %s: %s\n", feature, available)
+	}
+	fmt.Fprintf(&sb, "\nChecked at %s\n", locale.DateTime(capabilities.CheckedAt))
+	markdown := sb.String()
+
+	render.Markdown(markdown)
+	return nil
+}
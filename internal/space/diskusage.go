@@ -0,0 +1,50 @@
+package space
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// DiskUsageOptions contains options for the disk-usage command.
+type DiskUsageOptions struct {
+	Raw bool
+}
+
+// DiskUsage displays how much disk space the space uses, by feature and
+// by project.
+func DiskUsage(opts DiskUsageOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetSpaceDiskUsage()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	usage, err := backlog.ParseSpaceDiskUsage(data)
+	if err != nil {
+		return err
+	}
+
+	// Label projects by key where we can see them; others are shown by ID
+	projectKeys := map[int]string{}
+	if data, err := client.GetProjects(); err == nil {
+		if projects, err := backlog.ParseProjects(data); err == nil {
+			for _, project := range projects {
+				projectKeys[project.ID] = project.ProjectKey
+			}
+		}
+	}
+
+	markdown := backlog.FormatSpaceDiskUsageMarkdown(usage, projectKeys)
+
+	render.Markdown(markdown)
+	return nil
+}
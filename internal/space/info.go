@@ -0,0 +1,45 @@
+package space
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// InfoOptions contains options for the info command.
+type InfoOptions struct {
+	Raw bool
+}
+
+// Info displays the settings of the space the current profile points at.
+func Info(opts InfoOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetSpaceInfo()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	space, err := backlog.ParseSpace(data)
+	if err != nil {
+		return err
+	}
+
+	// The owner is shown by ID alone if the user lookup fails
+	var owner *backlog.User
+	if data, err := client.GetUser(space.OwnerID); err == nil {
+		owner, _ = backlog.ParseUser(data)
+	}
+
+	markdown := backlog.FormatSpaceMarkdown(client.GetSpace(), space, owner)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -0,0 +1,52 @@
+package space
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// LicenceOptions contains options for the licence command.
+type LicenceOptions struct {
+	Raw bool
+}
+
+// Licence displays the space licence: its status and its user, project,
+// issue, and storage limits, with current usage where it can be read.
+func Licence(opts LicenceOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetLicence()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	licence, err := backlog.ParseLicence(data)
+	if err != nil {
+		return err
+	}
+
+	// Usage is best effort: disk usage needs administrator rights
+	users := -1
+	if data, err := client.GetUsers(); err == nil {
+		if list, err := backlog.ParseUsers(data); err == nil {
+			users = len(list)
+		}
+	}
+	var storage *backlog.SpaceDiskUsage
+	if data, err := client.GetSpaceDiskUsage(); err == nil {
+		storage, _ = backlog.ParseSpaceDiskUsage(data)
+	}
+
+	markdown := backlog.FormatLicenceMarkdown(licence, users, storage)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -0,0 +1,74 @@
+package space
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// NotificationOptions contains options for the notification command. If
+// Set is true, the banner is replaced with Content; an empty Content
+// clears it.
+type NotificationOptions struct {
+	Raw     bool
+	Yes     bool
+	Set     bool
+	Content string
+}
+
+// Notification displays the space's notification banner, or replaces it.
+func Notification(opts NotificationOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if opts.Set {
+		if err := secrets.Check(opts.Content); err != nil {
+			return err
+		}
+
+		if !opts.Yes {
+			title := "Set the space notification shown to every user?"
+			description := opts.Content
+			if opts.Content == "" {
+				title = "Clear the space notification?"
+				description = ""
+			}
+			ok, err := prompt.Confirm(title, "Save", []string{description})
+			if err != nil {
+				return err
+			}
+			if !ok {
+				fmt.Println("Cancelled.")
+				return nil
+			}
+		}
+
+		data, err = client.UpdateSpaceNotification(opts.Content)
+	} else {
+		data, err = client.GetSpaceNotification()
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	notification, err := backlog.ParseSpaceNotification(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatSpaceNotificationMarkdown(notification)
+
+	render.Markdown(markdown)
+	return nil
+}
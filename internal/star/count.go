@@ -0,0 +1,67 @@
+package star
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/user"
+)
+
+// CountOptions contains options for the count command. Since and Until
+// are dates (yyyy-MM-dd) or period names, and bound the stars counted;
+// both ends are inclusive.
+type CountOptions struct {
+	Raw   bool
+	Since string
+	Until string
+}
+
+// Count prints the number of stars a user has received as a bare integer.
+// The user is given as in 'bgl user view'.
+func Count(query string, opts CountOptions) error {
+	params := url.Values{}
+	if opts.Since != "" {
+		since, _, err := parseDate(opts.Since)
+		if err != nil {
+			return err
+		}
+		params.Set("since", since.Format("2006-01-02"))
+	}
+	if opts.Until != "" {
+		_, until, err := parseDate(opts.Until)
+		if err != nil {
+			return err
+		}
+		params.Set("until", until.Format("2006-01-02"))
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	userID, err := user.ResolveUserID(client, query)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetStarCount(userID, params)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	count, err := backlog.ParseCount(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(count.Count)
+	return nil
+}
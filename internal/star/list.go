@@ -0,0 +1,131 @@
+package star
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/period"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/user"
+)
+
+// maxStarCount is the largest page the stars API returns.
+const maxStarCount = 100
+
+// ListOptions contains options for the list command. Since, if set, is a
+// date (yyyy-MM-dd) or period name; only stars given from then on are
+// listed. Limit caps the number of stars shown.
+type ListOptions struct {
+	Raw   bool
+	Since string
+	Limit int
+}
+
+// List displays the stars a user has received, newest first. The user is
+// given as in 'bgl user view'.
+func List(query string, opts ListOptions) error {
+	if opts.Limit <= 0 {
+		opts.Limit = 20
+	}
+
+	var since time.Time
+	if opts.Since != "" {
+		var err error
+		if since, _, err = parseDate(opts.Since); err != nil {
+			return err
+		}
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	userID, err := user.ResolveUserID(client, query)
+	if err != nil {
+		return err
+	}
+
+	data, err := fetchStars(client, userID, opts.Limit, since)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	stars, err := backlog.ParseStars(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatStarsMarkdown(stars)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// fetchStars fetches up to limit stars, newest first, paging back with
+// maxId and stopping at the first star older than since.
+func fetchStars(client *backlog.Client, userID int, limit int, since time.Time) ([]byte, error) {
+	all := []json.RawMessage{}
+	seen := map[int]bool{}
+	query := url.Values{}
+	for len(all) < limit {
+		count := min(limit-len(all), maxStarCount)
+		query.Set("count", strconv.Itoa(count))
+		data, err := client.GetStars(userID, query)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []json.RawMessage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse stars: %w", err)
+		}
+		stars, err := backlog.ParseStars(data)
+		if err != nil {
+			return nil, err
+		}
+
+		// Whether maxId is inclusive or not, skip anything already fetched
+		fetched := 0
+		for i, star := range stars {
+			if seen[star.ID] || len(all) >= limit {
+				continue
+			}
+			if !since.IsZero() {
+				if created, err := time.Parse(time.RFC3339, star.Created); err == nil && created.Before(since) {
+					return json.Marshal(all)
+				}
+			}
+			seen[star.ID] = true
+			fetched++
+			all = append(all, page[i])
+		}
+		if fetched == 0 || len(page) < count {
+			break
+		}
+		query.Set("maxId", strconv.Itoa(stars[len(stars)-1].ID))
+	}
+	return json.Marshal(all)
+}
+
+// parseDate parses a date (yyyy-MM-dd) or a period name, returning the
+// first and last day it covers in local time.
+func parseDate(s string) (first time.Time, last time.Time, err error) {
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, t, nil
+	}
+	p, err := period.Parse(s, time.Now())
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q (expected yyyy-MM-dd or %s)", s, period.Names)
+	}
+	return p.Start, p.End.AddDate(0, 0, -1), nil
+}
@@ -0,0 +1,100 @@
+package status
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// AddOptions contains options for the add command.
+type AddOptions struct {
+	Raw   bool
+	Yes   bool
+	Name  string
+	Color string
+}
+
+// Add adds a status to a project.
+func Add(projectIDOrKey string, opts AddOptions) error {
+	color, err := validateColor(opts.Color)
+	if err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	ok, err := confirm(opts.Yes, "Add Status?", "Add",
+		fmt.Sprintf("Space: %s\nProject: %s\nName: %s\nColor: %s", client.GetSpace(), projectIDOrKey, opts.Name, color))
+	if err != nil || !ok {
+		return err
+	}
+
+	data, err := client.AddStatus(projectIDOrKey, opts.Name, color)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	status, err := backlog.ParseProjectStatus(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Status added: %s (id: %d)\n", status.Name, status.ID)
+	return nil
+}
+
+// validateColor lowercases a status color and checks that the API accepts it.
+func validateColor(color string) (string, error) {
+	color = strings.ToLower(color)
+	if !slices.Contains(backlog.StatusColors, color) {
+		return "", fmt.Errorf("invalid color: %s (must be one of %s)", color, strings.Join(backlog.StatusColors, ", "))
+	}
+	return color, nil
+}
+
+// findStatus looks up a project's status by ID or name, returning it along
+// with all statuses of the project.
+func findStatus(client *backlog.Client, projectIDOrKey string, query string) (*backlog.ProjectStatus, []backlog.ProjectStatus, error) {
+	data, err := client.GetProjectStatuses(projectIDOrKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	statuses, err := backlog.ParseProjectStatuses(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	status, err := backlog.FindProjectStatus(statuses, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	return status, statuses, nil
+}
+
+// confirm shows a confirmation unless yes is set. It prints "Cancelled."
+// and returns false if declined.
+func confirm(yes bool, title string, affirmative string, description string) (bool, error) {
+	if yes {
+		return true, nil
+	}
+
+	ok, err := prompt.Confirm(title, affirmative, []string{description})
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		fmt.Println("Cancelled.")
+	}
+	return ok, nil
+}
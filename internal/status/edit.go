@@ -0,0 +1,71 @@
+package status
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// EditOptions contains options for the edit command. Empty fields are left
+// unchanged.
+type EditOptions struct {
+	Raw   bool
+	Yes   bool
+	Name  string
+	Color string
+}
+
+// Edit renames or recolors a status, given by ID or name.
+func Edit(projectIDOrKey string, statusIDOrName string, opts EditOptions) error {
+	if opts.Name == "" && opts.Color == "" {
+		return fmt.Errorf("nothing to update (use --name or --color)")
+	}
+	color := opts.Color
+	if color != "" {
+		var err error
+		if color, err = validateColor(color); err != nil {
+			return err
+		}
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	status, _, err := findStatus(client, projectIDOrKey, statusIDOrName)
+	if err != nil {
+		return err
+	}
+
+	description := fmt.Sprintf("Space: %s\nProject: %s\nStatus: %s (id: %d)", client.GetSpace(), projectIDOrKey, status.Name, status.ID)
+	if opts.Name != "" {
+		description += "\nName: " + opts.Name
+	}
+	if color != "" {
+		description += "\nColor: " + status.Color + " → " + color
+	}
+	ok, err := confirm(opts.Yes, "Update Status?", "Update", description)
+	if err != nil || !ok {
+		return err
+	}
+
+	data, err := client.UpdateStatus(projectIDOrKey, status.ID, opts.Name, color)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	updated, err := backlog.ParseProjectStatus(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Status updated: %s (id: %d)\n", updated.Name, updated.ID)
+	return nil
+}
@@ -0,0 +1,79 @@
+package status
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// OrderOptions contains options for the order command.
+type OrderOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// Order sets the display order of a project's statuses. The given statuses,
+// by ID or name, come first in that order; any others keep their relative
+// order after them.
+func Order(projectIDOrKey string, statusIDsOrNames []string, opts OrderOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetProjectStatuses(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+	statuses, err := backlog.ParseProjectStatuses(data)
+	if err != nil {
+		return err
+	}
+
+	var ordered []backlog.ProjectStatus
+	placed := map[int]bool{}
+	for _, query := range statusIDsOrNames {
+		status, err := backlog.FindProjectStatus(statuses, query)
+		if err != nil {
+			return err
+		}
+		if placed[status.ID] {
+			return fmt.Errorf("status given twice: %s", status.Name)
+		}
+		placed[status.ID] = true
+		ordered = append(ordered, *status)
+	}
+	for _, status := range statuses {
+		if !placed[status.ID] {
+			ordered = append(ordered, status)
+		}
+	}
+
+	ids := make([]int, len(ordered))
+	names := make([]string, len(ordered))
+	for i, status := range ordered {
+		ids[i] = status.ID
+		names[i] = status.Name
+	}
+
+	ok, err := confirm(opts.Yes, "Reorder Statuses?", "Reorder",
+		fmt.Sprintf("Space: %s\nProject: %s\nOrder: %s", client.GetSpace(), projectIDOrKey, strings.Join(names, " → ")))
+	if err != nil || !ok {
+		return err
+	}
+
+	data, err = client.UpdateStatusOrder(projectIDOrKey, ids)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fmt.Printf("Statuses reordered: %s\n", strings.Join(names, ", "))
+	return nil
+}
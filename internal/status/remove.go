@@ -0,0 +1,56 @@
+package status
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// RemoveOptions contains options for the rm command.
+type RemoveOptions struct {
+	Raw        bool
+	Yes        bool
+	Substitute string
+}
+
+// Remove deletes a status. Issues in the deleted status are moved to the
+// substitute status. Both statuses may be given by ID or name.
+func Remove(projectIDOrKey string, statusIDOrName string, opts RemoveOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	status, statuses, err := findStatus(client, projectIDOrKey, statusIDOrName)
+	if err != nil {
+		return err
+	}
+	substitute, err := backlog.FindProjectStatus(statuses, opts.Substitute)
+	if err != nil {
+		return err
+	}
+	if status.ID == substitute.ID {
+		return fmt.Errorf("substitute status must differ from the deleted one")
+	}
+
+	ok, err := confirm(opts.Yes, "Delete Status?", "Delete",
+		fmt.Sprintf("Space: %s\nProject: %s\nStatus: %s (id: %d)\nExisting issues move to: %s (id: %d)",
+			client.GetSpace(), projectIDOrKey, status.Name, status.ID, substitute.Name, substitute.ID))
+	if err != nil || !ok {
+		return err
+	}
+
+	data, err := client.DeleteStatus(projectIDOrKey, status.ID, substitute.ID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fmt.Printf("Status deleted: %s\n", status.Name)
+	return nil
+}
@@ -0,0 +1,39 @@
+package team
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw bool
+}
+
+// List displays the teams in the space with their member counts.
+func List(opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetTeams()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	teams, err := backlog.ParseTeams(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatTeamsMarkdown(teams)
+
+	render.Markdown(markdown)
+	return nil
+}
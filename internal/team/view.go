@@ -0,0 +1,52 @@
+package team
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ViewOptions contains options for the view command.
+type ViewOptions struct {
+	Raw bool
+}
+
+// View displays a team, given by numeric ID or name, with its members.
+func View(query string, opts ViewOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetTeams()
+	if err != nil {
+		return err
+	}
+	teams, err := backlog.ParseTeams(data)
+	if err != nil {
+		return err
+	}
+	found, err := backlog.FindTeam(teams, query)
+	if err != nil {
+		return err
+	}
+
+	data, err = client.GetTeam(found.ID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	team, err := backlog.ParseTeam(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatTeamMarkdown(team)
+
+	render.Markdown(markdown)
+	return nil
+}
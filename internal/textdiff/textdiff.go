@@ -0,0 +1,164 @@
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Line kinds of a diff line.
+const (
+	Context = ' '
+	Delete  = '-'
+	Insert  = '+'
+)
+
+// Line is a single line of a diff.
+type Line struct {
+	Kind byte
+	Text string
+}
+
+// Hunk is a group of changed lines with surrounding context, numbered as in
+// a unified diff.
+type Hunk struct {
+	OldStart, OldLines int
+	NewStart, NewLines int
+	Lines              []Line
+}
+
+// Header returns the hunk's "@@ -a,b +c,d @@" header.
+func (h Hunk) Header() string {
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@", h.OldStart, h.OldLines, h.NewStart, h.NewLines)
+}
+
+// Lines compares old and new line by line, returning every line tagged as
+// context, deleted, or inserted.
+func Lines(oldText, newText string) []Line {
+	a := split(oldText)
+	b := split(newText)
+
+	// Longest common subsequence table, built from the end
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []Line
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, Line{Context, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, Line{Delete, a[i]})
+			i++
+		default:
+			lines = append(lines, Line{Insert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, Line{Delete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, Line{Insert, b[j]})
+	}
+	return lines
+}
+
+// Unified groups the differences between old and new into hunks with the
+// given number of context lines. It returns nil if the texts are equal.
+func Unified(oldText, newText string, context int) []Hunk {
+	lines := Lines(oldText, newText)
+
+	var hunks []Hunk
+	var cur *Hunk
+	oldLine, newLine := 1, 1
+	lastChange := -1
+	for idx, line := range lines {
+		if line.Kind != Context {
+			if cur == nil || idx-lastChange > 2*context {
+				// Start a new hunk with up to context lines of leading context
+				start := max(idx-context, lastChange+1, 0)
+				if cur != nil {
+					for _, l := range lines[lastChange+1 : lastChange+1+context] {
+						cur.add(l)
+					}
+					hunks = append(hunks, *cur)
+				}
+				back := idx - start
+				cur = &Hunk{OldStart: oldLine - back, NewStart: newLine - back}
+				for _, l := range lines[start:idx] {
+					cur.add(l)
+				}
+			} else {
+				for _, l := range lines[lastChange+1 : idx] {
+					cur.add(l)
+				}
+			}
+			cur.add(line)
+			lastChange = idx
+		}
+
+		switch line.Kind {
+		case Context:
+			oldLine++
+			newLine++
+		case Delete:
+			oldLine++
+		case Insert:
+			newLine++
+		}
+	}
+	if cur == nil {
+		return nil
+	}
+	// Trailing context
+	end := min(lastChange+1+context, len(lines))
+	for _, l := range lines[lastChange+1 : end] {
+		cur.add(l)
+	}
+	hunks = append(hunks, *cur)
+
+	// Empty sides start at line 0, as in diff(1)
+	for i := range hunks {
+		if hunks[i].OldLines == 0 {
+			hunks[i].OldStart--
+		}
+		if hunks[i].NewLines == 0 {
+			hunks[i].NewStart--
+		}
+	}
+	return hunks
+}
+
+func (h *Hunk) add(line Line) {
+	h.Lines = append(h.Lines, line)
+	if line.Kind != Insert {
+		h.OldLines++
+	}
+	if line.Kind != Delete {
+		h.NewLines++
+	}
+}
+
+// split splits text into lines, treating CRLF as LF and ignoring a final
+// newline.
+func split(text string) []string {
+	if text == "" {
+		return nil
+	}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,228 @@
+package usage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dannygim/bgl/internal/config"
+	"github.com/dannygim/bgl/internal/locale"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// statsFileName is the name of the usage counters file in the state dir.
+const statsFileName = "stats.json"
+
+// Stats holds local usage counters. They are never sent anywhere.
+type Stats struct {
+	Since    time.Time      `json:"since"`
+	Commands map[string]int `json:"commands"`
+	Errors   map[string]int `json:"errors"`
+}
+
+func getStatsPath() (string, error) {
+	stateDir, err := config.GetStateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(stateDir, statsFileName), nil
+}
+
+func load() (*Stats, error) {
+	path, err := getStatsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{Since: time.Now(), Commands: map[string]int{}, Errors: map[string]int{}}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return stats, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, stats); err != nil {
+		return nil, fmt.Errorf("failed to parse usage stats: %w", err)
+	}
+	return stats, nil
+}
+
+func (s *Stats) save() error {
+	stateDir, err := config.GetStateDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return err
+	}
+
+	path, err := getStatsPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// enabled reports whether usage counters are turned on.
+func enabled() bool {
+	cfg, err := config.Load()
+	return err == nil && cfg.UsageStats
+}
+
+// update applies fn to the stats if counting is enabled. Failures are
+// ignored so counting never breaks a command.
+func update(fn func(*Stats)) {
+	if !enabled() {
+		return
+	}
+	stats, err := load()
+	if err != nil {
+		return
+	}
+	fn(stats)
+	_ = stats.save()
+}
+
+// RecordCommand counts a run of the named command.
+func RecordCommand(name string) {
+	update(func(s *Stats) {
+		s.Commands[name]++
+	})
+}
+
+// RecordError counts a command error by category.
+func RecordError(err error) {
+	update(func(s *Stats) {
+		s.Errors[category(err)]++
+	})
+}
+
+// category classifies an error without recording its message.
+func category(err error) string {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return "network"
+	}
+
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "not logged in"), strings.Contains(msg, "access token"),
+		strings.Contains(msg, "authentication failed"):
+		return "auth"
+	case strings.Contains(msg, "API request failed with status 4"):
+		return "api_4xx"
+	case strings.Contains(msg, "API request failed with status 5"):
+		return "api_5xx"
+	case strings.Contains(msg, "cancelled by user"), strings.Contains(msg, "confirmation failed"):
+		return "cancelled"
+	}
+	return "other"
+}
+
+// Options contains options for the stats command.
+type Options struct {
+	Raw     bool
+	Enable  bool
+	Disable bool
+	Reset   bool
+}
+
+// Show displays the usage counters, or enables, disables, or resets them.
+func Show(opts Options) error {
+	if opts.Enable || opts.Disable {
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		cfg.UsageStats = opts.Enable
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+		if opts.Enable {
+			fmt.Println("Usage stats enabled. Counters are stored locally and never sent anywhere.")
+		} else {
+			fmt.Println("Usage stats disabled.")
+		}
+		return nil
+	}
+
+	if opts.Reset {
+		path, err := getStatsPath()
+		if err != nil {
+			return err
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		fmt.Println("Usage stats reset.")
+		return nil
+	}
+
+	stats, err := load()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		formatted, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+		return nil
+	}
+
+	if !enabled() {
+		fmt.Println("Usage stats are disabled. Run 'bgl stats --self --enable' to turn them on.")
+		if len(stats.Commands) == 0 {
+			return nil
+		}
+		fmt.Println()
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## Commands (since %s)\n", locale.Date(stats.Since))
+	writeCounts(&sb, stats.Commands)
+	sb.WriteString("\n## Errors\n")
+	writeCounts(&sb, stats.Errors)
+	markdown := sb.String()
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// writeCounts writes counters as a list, most frequent first.
+func writeCounts(sb *strings.Builder, counts map[string]int) {
+	if len(counts) == 0 {
+		sb.WriteString("- (none)\n")
+		return
+	}
+
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if counts[names[i]] != counts[names[j]] {
+			return counts[names[i]] > counts[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	for _, name := range names {
+		fmt.Fprintf(sb, "- %s: %s\n", name, locale.Number(int64(counts[name])))
+	}
+}
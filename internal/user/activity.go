@@ -0,0 +1,54 @@
+package user
+
+import (
+	"net/url"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ActivityOptions contains options for the activity command.
+type ActivityOptions struct {
+	Raw   bool
+	Limit int
+}
+
+// Activity displays the recent activities of a user, newest first. The
+// user is given as in View.
+func Activity(query string, opts ActivityOptions) error {
+	if opts.Limit <= 0 {
+		opts.Limit = 30
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	userID, err := ResolveUserID(client, query)
+	if err != nil {
+		return err
+	}
+
+	data, err := backlog.FetchActivities(opts.Limit, url.Values{}, func(query url.Values) ([]byte, error) {
+		return client.GetUserActivities(userID, query)
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	activities, err := backlog.ParseActivities(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatCrossProjectActivitiesMarkdown(activities)
+
+	render.Markdown(markdown)
+	return nil
+}
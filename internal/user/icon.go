@@ -0,0 +1,54 @@
+package user
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/locale"
+)
+
+// IconOptions contains options for the icon command. Output is the file to
+// write, or "-" for standard output; by default the server's file name is
+// used.
+type IconOptions struct {
+	Output string
+}
+
+// Icon downloads a user's icon. The user is given as in View.
+func Icon(query string, opts IconOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	userID, err := ResolveUserID(client, query)
+	if err != nil {
+		return err
+	}
+
+	content, filename, err := client.DownloadUserIcon(userID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output == "-" {
+		_, err := os.Stdout.Write(content)
+		return err
+	}
+
+	out := opts.Output
+	if out == "" {
+		out = filepath.Base(filename)
+		if filename == "" {
+			out = fmt.Sprintf("%d.png", userID)
+		}
+	}
+	if err := os.WriteFile(out, content, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	fmt.Printf("Downloaded: %s (%s bytes)\n", out, locale.Number(int64(len(content))))
+	return nil
+}
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command. Query keeps only
+// users whose name, user ID, or mail address contains it.
+type ListOptions struct {
+	Raw   bool
+	Query string
+}
+
+// List displays the users in the space.
+func List(opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetUsers()
+	if err != nil {
+		return err
+	}
+
+	// Keep the raw entries alongside the parsed users so --raw output can be
+	// filtered too
+	var entries []json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("failed to parse users: %w", err)
+	}
+	users, err := backlog.ParseUsers(data)
+	if err != nil {
+		return err
+	}
+
+	matched := []backlog.User{}
+	matchedEntries := []json.RawMessage{}
+	for i := range users {
+		if opts.Query == "" || backlog.MatchUser(&users[i], opts.Query) {
+			matched = append(matched, users[i])
+			matchedEntries = append(matchedEntries, entries[i])
+		}
+	}
+
+	if opts.Raw {
+		formatted, err := json.MarshalIndent(matchedEntries, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+		return nil
+	}
+
+	markdown := backlog.FormatUsersMarkdown(matched)
+
+	render.Markdown(markdown)
+	return nil
+}
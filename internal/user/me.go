@@ -0,0 +1,39 @@
+package user
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// MeOptions contains options for the me command.
+type MeOptions struct {
+	Raw bool
+}
+
+// Me displays the authenticated user.
+func Me(opts MeOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetMyself()
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	user, err := backlog.ParseUser(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatUserMarkdown(user)
+
+	render.Markdown(markdown)
+	return nil
+}
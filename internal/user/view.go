@@ -0,0 +1,80 @@
+package user
+
+import (
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ViewOptions contains options for the view command.
+type ViewOptions struct {
+	Raw bool
+}
+
+// View displays a user, given as "me", a numeric ID, or a user ID, name,
+// or mail address.
+func View(query string, opts ViewOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	userID, err := ResolveUserID(client, query)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetUser(userID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	user, err := backlog.ParseUser(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatUserMarkdown(user)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// ResolveUserID resolves "me", a numeric ID, or a user ID, name, or mail
+// address to a numeric user ID.
+func ResolveUserID(client *backlog.Client, query string) (int, error) {
+	if query == "me" {
+		data, err := client.GetMyself()
+		if err != nil {
+			return 0, err
+		}
+		user, err := backlog.ParseUser(data)
+		if err != nil {
+			return 0, err
+		}
+		return user.ID, nil
+	}
+	if id, err := strconv.Atoi(query); err == nil {
+		return id, nil
+	}
+
+	data, err := client.GetUsers()
+	if err != nil {
+		return 0, err
+	}
+	users, err := backlog.ParseUsers(data)
+	if err != nil {
+		return 0, err
+	}
+	user, err := backlog.FindUser(users, query)
+	if err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
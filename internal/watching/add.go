@@ -0,0 +1,45 @@
+package watching
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// AddOptions contains options for the add command.
+type AddOptions struct {
+	Raw  bool
+	Note string
+}
+
+// Add starts watching an issue.
+func Add(issueKeyOrID string, opts AddOptions) error {
+	if err := secrets.Check(opts.Note); err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.AddWatching(issueKeyOrID, opts.Note)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	watching, err := backlog.ParseWatching(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Watching %s (id: %d)\n", issueKeyOrID, watching.ID)
+	return nil
+}
@@ -0,0 +1,135 @@
+package watching
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// maxWatchingCount is the largest page the watch list API returns.
+const maxWatchingCount = 100
+
+// ListOptions contains options for the list command. With Unread, only
+// issues updated since they were last read are listed.
+type ListOptions struct {
+	Raw    bool
+	Unread bool
+}
+
+// List displays your watch list, most recently updated first.
+func List(opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	userID, err := myUserID(client)
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	if opts.Unread {
+		query.Set("resourceAlreadyRead", "false")
+	}
+	data, err := fetchWatchings(client, userID, query)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	watchings, err := backlog.ParseWatchings(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatWatchingsMarkdown(watchings)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// fetchWatchings fetches a user's whole watch list, paging with offset.
+func fetchWatchings(client *backlog.Client, userID int, query url.Values) ([]byte, error) {
+	query.Set("count", strconv.Itoa(maxWatchingCount))
+
+	all := []json.RawMessage{}
+	for {
+		query.Set("offset", strconv.Itoa(len(all)))
+		data, err := client.GetWatchings(userID, query)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []json.RawMessage
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse watchings: %w", err)
+		}
+		all = append(all, page...)
+		if len(page) < maxWatchingCount {
+			break
+		}
+	}
+	return json.Marshal(all)
+}
+
+// myUserID returns the numeric ID of the authenticated user.
+func myUserID(client *backlog.Client) (int, error) {
+	data, err := client.GetMyself()
+	if err != nil {
+		return 0, err
+	}
+	user, err := backlog.ParseUser(data)
+	if err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
+
+// resolveWatching resolves a watching ID, or the key of an issue on your
+// watch list, to a watching.
+func resolveWatching(client *backlog.Client, watchingOrIssue string) (*backlog.Watching, error) {
+	if id, err := strconv.Atoi(watchingOrIssue); err == nil {
+		data, err := client.GetWatching(id)
+		if err != nil {
+			return nil, err
+		}
+		return backlog.ParseWatching(data)
+	}
+
+	data, err := client.GetIssue(watchingOrIssue)
+	if err != nil {
+		return nil, err
+	}
+	issue, err := backlog.ParseIssue(data)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := myUserID(client)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{}
+	query.Set("issueId[]", strconv.Itoa(issue.ID))
+	data, err = client.GetWatchings(userID, query)
+	if err != nil {
+		return nil, err
+	}
+	watchings, err := backlog.ParseWatchings(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(watchings) == 0 {
+		return nil, fmt.Errorf("not watching %s", issue.IssueKey)
+	}
+	return &watchings[0], nil
+}
@@ -0,0 +1,49 @@
+package watching
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// NoteOptions contains options for the note command.
+type NoteOptions struct {
+	Raw bool
+}
+
+// Note sets the note of a watching, given by ID or by the watched issue's
+// key. An empty note clears it.
+func Note(watchingOrIssue string, note string, opts NoteOptions) error {
+	if err := secrets.Check(note); err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	watching, err := resolveWatching(client, watchingOrIssue)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.UpdateWatching(watching.ID, note)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	if note == "" {
+		fmt.Printf("Note cleared (watching id: %d)\n", watching.ID)
+	} else {
+		fmt.Printf("Note saved (watching id: %d)\n", watching.ID)
+	}
+	return nil
+}
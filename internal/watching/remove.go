@@ -0,0 +1,58 @@
+package watching
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// RemoveOptions contains options for the remove command.
+type RemoveOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// Remove stops a watching, given by ID or by the watched issue's key.
+func Remove(watchingOrIssue string, opts RemoveOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	watching, err := resolveWatching(client, watchingOrIssue)
+	if err != nil {
+		return err
+	}
+
+	target := fmt.Sprintf("watching %d", watching.ID)
+	if watching.Issue != nil {
+		target = watching.Issue.IssueKey + " " + watching.Issue.Summary
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		ok, err := prompt.Confirm("Stop Watching?", "Stop watching", []string{"Space: " + client.GetSpace(), target})
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	data, err := client.DeleteWatching(watching.ID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fmt.Printf("Stopped watching %s\n", target)
+	return nil
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// AddOptions contains options for the add command. Events is a
+// comma-separated list of activity type names or IDs; empty means all
+// events.
+type AddOptions struct {
+	Raw         bool
+	Yes         bool
+	Name        string
+	Description string
+	URL         string
+	Events      string
+}
+
+// Add adds a webhook to a project.
+func Add(projectIDOrKey string, opts AddOptions) error {
+	hookURL, err := url.Parse(opts.URL)
+	if err != nil || (hookURL.Scheme != "http" && hookURL.Scheme != "https") || hookURL.Host == "" {
+		return fmt.Errorf("invalid webhook URL: %s", opts.URL)
+	}
+
+	activityTypeIDs, err := backlog.ParseActivityTypes(opts.Events)
+	if err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		events := backlog.WebhookEvents(&backlog.Webhook{AllEvent: len(activityTypeIDs) == 0, ActivityTypeIDs: activityTypeIDs})
+		confirm, err := prompt.Confirm("Add Webhook?", "Add", []string{
+			"Space: " + client.GetSpace(),
+			"Project: " + projectIDOrKey,
+			"Name: " + opts.Name,
+			"URL: " + opts.URL,
+			"Events: " + events,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	data, err := client.AddWebhook(projectIDOrKey, opts.Name, opts.Description, opts.URL, activityTypeIDs)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	webhook, err := backlog.ParseWebhook(data)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Webhook added: %s (id: %d)\n", webhook.Name, webhook.ID)
+	return nil
+}
+
+// PrintActivityTypes prints the activity types accepted by --events.
+func PrintActivityTypes() {
+	for _, activityType := range backlog.ActivityTypes {
+		fmt.Printf("  %-28s %s\n", activityType.Name, activityType.Label)
+	}
+}
@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// DeleteOptions contains options for the delete command.
+type DeleteOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// Delete deletes a webhook, given by ID or name.
+func Delete(projectIDOrKey string, webhookIDOrName string, opts DeleteOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetWebhooks(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+	webhooks, err := backlog.ParseWebhooks(data)
+	if err != nil {
+		return err
+	}
+	webhook, err := backlog.FindWebhook(webhooks, webhookIDOrName)
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		confirm, err := prompt.Confirm("Delete Webhook?", "Delete", []string{
+			"Space: " + client.GetSpace(),
+			"Project: " + projectIDOrKey,
+			fmt.Sprintf("Webhook: %s (id: %d)", webhook.Name, webhook.ID),
+			"URL: " + webhook.HookURL,
+		})
+		if err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	data, err = client.DeleteWebhook(projectIDOrKey, webhook.ID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	fmt.Printf("Webhook deleted: %s\n", webhook.Name)
+	return nil
+}
@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw bool
+}
+
+// List displays the webhooks of a project.
+func List(projectIDOrKey string, opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetWebhooks(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	webhooks, err := backlog.ParseWebhooks(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatWebhooksMarkdown(webhooks)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -0,0 +1,157 @@
+package wiki
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/locale"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// AttachOptions contains options for the attach command.
+type AttachOptions struct {
+	Raw bool
+	Yes bool
+}
+
+// AttachmentsOptions contains options for the attachments command. With
+// Download, every attachment is saved into Dir (default: the current
+// directory) instead of being listed.
+type AttachmentsOptions struct {
+	Raw      bool
+	Download bool
+	Dir      string
+}
+
+// Attach uploads local files and attaches them to a wiki page.
+func Attach(page string, files []string, opts AttachOptions) error {
+	for _, file := range files {
+		if _, err := os.Stat(file); err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+	}
+	if err := secrets.CheckFiles(files...); err != nil {
+		return err
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Require(backlog.CapabilityWikiAttachment); err != nil {
+		return err
+	}
+
+	wikiID, err := resolveWikiID(client, page)
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		details := []string{"Space: " + client.GetSpace(), "Page: " + page}
+		for _, file := range files {
+			details = append(details, "File: "+file)
+		}
+		ok, err := prompt.Confirm("Attach Files?", "Attach", details)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	uploaded, err := client.UploadFiles(files)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.AddWikiAttachments(wikiID, backlog.AttachmentIDs(uploaded))
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	attachments, err := backlog.ParseAttachments(data)
+	if err != nil {
+		return err
+	}
+	for _, attachment := range attachments {
+		fmt.Printf("Attached: %s (id: %d)\n", attachment.Name, attachment.ID)
+	}
+	return nil
+}
+
+// Attachments lists the attachments of a wiki page, or downloads them all.
+func Attachments(page string, opts AttachmentsOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	wikiID, err := resolveWikiID(client, page)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetWikiAttachments(wikiID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw && !opts.Download {
+		render.JSON(data)
+		return nil
+	}
+
+	attachments, err := backlog.ParseAttachments(data)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Download {
+		render.Markdown(backlog.FormatAttachmentsMarkdown(attachments))
+		return nil
+	}
+
+	if len(attachments) == 0 {
+		fmt.Println("No attachments found.")
+		return nil
+	}
+
+	return downloadAttachments(client, wikiID, attachments, opts.Dir)
+}
+
+// downloadAttachments saves attachments of a wiki page into dir.
+func downloadAttachments(client *backlog.Client, wikiID string, attachments []backlog.Attachment, dir string) error {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+	}
+
+	for _, attachment := range attachments {
+		content, _, err := client.DownloadWikiAttachment(wikiID, strconv.Itoa(attachment.ID))
+		if err != nil {
+			return fmt.Errorf("failed to download %s: %w", attachment.Name, err)
+		}
+		out := filepath.Join(dir, filepath.Base(attachment.Name))
+		if err := os.WriteFile(out, content, 0644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("Downloaded: %s (%s bytes)\n", out, locale.Number(int64(len(content))))
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package wiki
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// CreateOptions contains options for the create command.
+type CreateOptions struct {
+	Raw        bool
+	Yes        bool
+	BodyFile   string
+	Tags       []string
+	MailNotify bool
+}
+
+// Create creates a wiki page in a project from a local file (or stdin for
+// "-"). Tags are added by prefixing the page name with [tag], which is how
+// Backlog tags wiki pages.
+func Create(projectIDOrKey string, name string, opts CreateOptions) error {
+	var data []byte
+	var err error
+	if opts.BodyFile == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(opts.BodyFile)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read wiki content: %w", err)
+	}
+
+	content := strings.TrimRight(string(data), "\n")
+	if strings.TrimSpace(content) == "" {
+		return fmt.Errorf("wiki content cannot be empty")
+	}
+
+	if err := secrets.Check(content); err != nil {
+		return err
+	}
+
+	name = taggedName(name, opts.Tags)
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	// Resolve the project key to its numeric ID
+	projectData, err := client.GetProject(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+	project, err := backlog.ParseProject(projectData)
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		details := []string{
+			"Space: " + client.GetSpace(),
+			"Project: " + project.ProjectKey,
+			"Name: " + name,
+			fmt.Sprintf("Content: %d lines", strings.Count(content, "\n")+1),
+		}
+		if opts.MailNotify {
+			details = append(details, "Mail notification: yes")
+		}
+		ok, err := prompt.Confirm("Create Wiki Page?", "Create", details)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	result, err := client.AddWiki(project.ID, name, content, opts.MailNotify)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	wiki, err := backlog.ParseWiki(result)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Wiki page created: %s (id: %d)\n", wiki.Name, wiki.ID)
+	return nil
+}
+
+// taggedName prefixes a page name with [tag] for each tag not already on it.
+func taggedName(name string, tags []string) string {
+	var prefix strings.Builder
+	for _, tag := range tags {
+		if tag == "" || strings.Contains(name, "["+tag+"]") {
+			continue
+		}
+		prefix.WriteString("[" + tag + "]")
+	}
+	if prefix.Len() == 0 {
+		return name
+	}
+	if strings.HasPrefix(name, "[") {
+		// Join an existing tag prefix
+		return prefix.String() + name
+	}
+	return prefix.String() + " " + name
+}
@@ -0,0 +1,71 @@
+package wiki
+
+import (
+	"fmt"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// DeleteOptions contains options for the delete command.
+type DeleteOptions struct {
+	Raw        bool
+	Yes        bool
+	MailNotify bool
+}
+
+// Delete deletes a wiki page by its ID or by PROJ:PageName.
+func Delete(page string, opts DeleteOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	wikiID, err := resolveWikiID(client, page)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetWiki(wikiID)
+	if err != nil {
+		return err
+	}
+	wiki, err := backlog.ParseWiki(data)
+	if err != nil {
+		return err
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		details := []string{
+			"Space: " + client.GetSpace(),
+			fmt.Sprintf("Page: %s (id: %d)", wiki.Name, wiki.ID),
+			"The page and its attachments cannot be restored.",
+		}
+		if opts.MailNotify {
+			details = append(details, "Mail notification: yes")
+		}
+		ok, err := prompt.Confirm("Delete Wiki Page?", "Delete", details)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	result, err := client.DeleteWiki(wikiID, opts.MailNotify)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	fmt.Printf("Wiki page deleted: %s\n", wiki.Name)
+	return nil
+}
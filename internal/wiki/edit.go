@@ -0,0 +1,192 @@
+package wiki
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/editor"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// EditOptions contains options for the edit command.
+type EditOptions struct {
+	Raw        bool
+	Yes        bool
+	Force      bool
+	Name       string
+	MailNotify bool
+	Tags       []string
+	Untags     []string
+}
+
+// Edit opens the editor on a wiki page's current content and updates the
+// page on save. If the page changed remotely while it was being edited, the
+// update is only made after confirming the overwrite (or with Force).
+// When tags are added or removed, only the page name is changed and the
+// editor is not opened.
+func Edit(page string, opts EditOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	wikiID, err := resolveWikiID(client, page)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetWiki(wikiID)
+	if err != nil {
+		return err
+	}
+	current, err := backlog.ParseWiki(data)
+	if err != nil {
+		return err
+	}
+
+	retag := len(opts.Tags) > 0 || len(opts.Untags) > 0
+
+	content := strings.TrimRight(current.Content, "\n")
+	if !retag {
+		content, err = editor.Edit(current.Content)
+		if err != nil {
+			return err
+		}
+		content = strings.TrimRight(content, "\n")
+	}
+
+	name := current.Name
+	if opts.Name != "" {
+		name = opts.Name
+	}
+	name = taggedName(untaggedName(name, opts.Untags), opts.Tags)
+
+	if content == strings.TrimRight(current.Content, "\n") && name == current.Name {
+		fmt.Println("No changes.")
+		return nil
+	}
+
+	if !retag {
+		if strings.TrimSpace(content) == "" {
+			return fmt.Errorf("wiki content cannot be empty")
+		}
+
+		if err := secrets.Check(content); err != nil {
+			return err
+		}
+
+		proceed, err := checkRemoteChanges(client, wikiID, current, content, opts.Force)
+		if err != nil || !proceed {
+			return err
+		}
+	}
+
+	update := url.Values{}
+	if !retag {
+		update.Set("content", content)
+	}
+	if name != current.Name {
+		update.Set("name", name)
+	}
+	if opts.MailNotify {
+		update.Set("mailNotify", "true")
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		details := []string{"Space: " + client.GetSpace(), "Page: " + current.Name}
+		if name != current.Name {
+			details = append(details, "New name: "+name)
+		}
+		if !retag {
+			details = append(details, fmt.Sprintf("Content: %d lines", strings.Count(content, "\n")+1))
+		}
+		if opts.MailNotify {
+			details = append(details, "Mail notification: yes")
+		}
+		ok, err := prompt.Confirm("Update Wiki Page?", "Update", details)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	result, err := client.UpdateWiki(wikiID, update)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(result)
+		return nil
+	}
+
+	wiki, err := backlog.ParseWiki(result)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Wiki page updated: %s (id: %d)\n", wiki.Name, wiki.ID)
+	return nil
+}
+
+// checkRemoteChanges reports whether to go on with an update when the page
+// was saved by someone else while it was in the editor. Unless force is
+// set, the overwrite must be confirmed; if it is not, the edited content
+// is saved to a temporary file.
+func checkRemoteChanges(client *backlog.Client, wikiID string, current *backlog.Wiki, content string, force bool) (bool, error) {
+	data, err := client.GetWiki(wikiID)
+	if err != nil {
+		return false, err
+	}
+	latest, err := backlog.ParseWiki(data)
+	if err != nil {
+		return false, err
+	}
+	if latest.Updated == current.Updated || force {
+		return true, nil
+	}
+
+	updatedBy := "someone else"
+	if latest.UpdatedUser != nil {
+		updatedBy = latest.UpdatedUser.Name
+	}
+	fmt.Fprintf(os.Stderr, "Warning: %s was updated by %s at %s after you started editing.\n", latest.Name, updatedBy, latest.Updated)
+
+	ok, err := prompt.Confirm("Overwrite Remote Changes?", "Overwrite", []string{
+		"Saving will discard the changes made remotely since " + current.Updated + ".",
+	})
+	if err != nil || !ok {
+		if path, saveErr := saveDraft(content); saveErr == nil {
+			fmt.Printf("Your edits were saved to %s\n", path)
+		}
+		if err != nil {
+			return false, err
+		}
+		fmt.Println("Cancelled.")
+		return false, nil
+	}
+	return true, nil
+}
+
+// saveDraft writes edited content to a temporary file so it is not lost
+// when an update is abandoned, and returns the file's path.
+func saveDraft(content string) (string, error) {
+	f, err := os.CreateTemp("", "bgl-wiki-*.md")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.WriteString(content + "\n"); err != nil {
+		return "", err
+	}
+	return f.Name(), nil
+}
@@ -0,0 +1,221 @@
+package wiki
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dannygim/bgl/internal/backlog"
+)
+
+// manifestFileName is the name of the manifest written to the export
+// directory. Sync reads it to find the page behind each file.
+const manifestFileName = ".bgl-wiki.json"
+
+// ExportOptions contains options for the export command.
+type ExportOptions struct {
+	Attachments bool
+}
+
+// Manifest describes the pages saved by Export.
+type Manifest struct {
+	Space      string          `json:"space"`
+	Project    string          `json:"project"`
+	ExportedAt time.Time       `json:"exportedAt"`
+	Pages      []ManifestEntry `json:"pages"`
+}
+
+// ManifestEntry describes one exported page. Updated and Hash record the
+// remote version and the content as last exported or synced, so local
+// edits and remote changes can be told apart.
+type ManifestEntry struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	File    string `json:"file"`
+	Updated string `json:"updated"`
+	Hash    string `json:"hash"`
+}
+
+// Export downloads every wiki page of a project into dir as Markdown files,
+// one per page, and writes a manifest for Sync. Page names containing "/"
+// are saved in subdirectories. With Attachments, each page's attachments
+// are saved next to it in a <page>.files directory.
+func Export(projectIDOrKey string, dir string, opts ExportOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetWikis(projectIDOrKey, nil)
+	if err != nil {
+		return err
+	}
+	wikis, err := backlog.ParseWikis(data)
+	if err != nil {
+		return err
+	}
+
+	if len(wikis) == 0 {
+		fmt.Println("No wiki pages found.")
+		return nil
+	}
+
+	manifest := Manifest{
+		Space:      client.GetSpace(),
+		Project:    projectIDOrKey,
+		ExportedAt: time.Now(),
+	}
+
+	// On Ctrl-C, finish the page being exported, then write the manifest
+	// for the pages saved so far.
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+
+	used := map[string]bool{}
+	for i, listed := range wikis {
+		select {
+		case <-interrupted:
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return fmt.Errorf("failed to create directory: %w", err)
+			}
+			if err := writeManifest(dir, &manifest); err != nil {
+				return err
+			}
+			fmt.Printf("Manifest: %s\n", filepath.Join(dir, manifestFileName))
+			fmt.Printf("Interrupted before %s, after %d of %d page(s). Run 'bgl wiki export' again to export every page.\n",
+				listed.Name, i, len(wikis))
+			return nil
+		default:
+		}
+
+		data, err := client.GetWiki(strconv.Itoa(listed.ID))
+		if err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", listed.Name, err)
+		}
+		wiki, err := backlog.ParseWiki(data)
+		if err != nil {
+			return err
+		}
+
+		file := pageFile(wiki.Name, wiki.ID, used)
+		path := filepath.Join(dir, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.WriteFile(path, []byte(wiki.Content), 0644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		fmt.Printf("Exported: %s\n", path)
+
+		manifest.Pages = append(manifest.Pages, ManifestEntry{
+			ID:      wiki.ID,
+			Name:    wiki.Name,
+			File:    filepath.ToSlash(file),
+			Updated: wiki.Updated,
+			Hash:    contentHash(wiki.Content),
+		})
+
+		if opts.Attachments {
+			if err := exportAttachments(client, wiki, strings.TrimSuffix(path, ".md")+".files"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := writeManifest(dir, &manifest); err != nil {
+		return err
+	}
+	fmt.Printf("Manifest: %s\n", filepath.Join(dir, manifestFileName))
+	return nil
+}
+
+// exportAttachments downloads a page's attachments into dir, if it has any.
+func exportAttachments(client *backlog.Client, wiki *backlog.Wiki, dir string) error {
+	wikiID := strconv.Itoa(wiki.ID)
+	data, err := client.GetWikiAttachments(wikiID)
+	if err != nil {
+		return err
+	}
+	attachments, err := backlog.ParseAttachments(data)
+	if err != nil {
+		return err
+	}
+	if len(attachments) == 0 {
+		return nil
+	}
+	return downloadAttachments(client, wikiID, attachments, dir)
+}
+
+// pageFile returns the relative path of the Markdown file for a page name,
+// unique within used (case-insensitive). Each "/"-separated part of the
+// name becomes a path element, with characters not allowed in file names
+// replaced.
+func pageFile(name string, id int, used map[string]bool) string {
+	var parts []string
+	for _, part := range strings.Split(name, "/") {
+		part = strings.TrimSpace(strings.Map(func(r rune) rune {
+			if strings.ContainsRune(`\:*?"<>|`, r) || r < 0x20 {
+				return '_'
+			}
+			return r
+		}, part))
+		if part == "" || part == "." || part == ".." {
+			continue
+		}
+		parts = append(parts, part)
+	}
+	if len(parts) == 0 {
+		parts = []string{"page-" + strconv.Itoa(id)}
+	}
+
+	base := filepath.Join(parts...)
+	candidate := base + ".md"
+	for n := 2; used[strings.ToLower(candidate)]; n++ {
+		candidate = fmt.Sprintf("%s (%d).md", base, n)
+	}
+	used[strings.ToLower(candidate)] = true
+	return candidate
+}
+
+// contentHash returns the hex SHA-256 of page content, ignoring trailing
+// newlines that editors tend to add.
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(strings.TrimRight(content, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// readManifest reads the manifest in an export directory.
+func readManifest(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%s is not a wiki export (no %s); run 'bgl wiki export' first", dir, manifestFileName)
+		}
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// writeManifest writes the manifest to an export directory.
+func writeManifest(dir string, manifest *Manifest) error {
+	out, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, manifestFileName), out, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
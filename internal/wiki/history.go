@@ -0,0 +1,196 @@
+package wiki
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/locale"
+	"github.com/dannygim/bgl/internal/render"
+	"github.com/dannygim/bgl/internal/textdiff"
+)
+
+// HistoryOptions contains options for the history command.
+type HistoryOptions struct {
+	Raw bool
+}
+
+// ANSI colors for diff output.
+const (
+	colorReset = "\x1b[0m"
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorCyan  = "\x1b[36m"
+	colorBold  = "\x1b[1m"
+)
+
+// History lists the revisions of a wiki page, oldest first.
+func History(page string, opts HistoryOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	wikiID, err := resolveWikiID(client, page)
+	if err != nil {
+		return err
+	}
+
+	revisions, err := fetchHistory(client, wikiID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		formatted, err := json.MarshalIndent(revisions, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+		return nil
+	}
+
+	render.Markdown(formatHistoryMarkdown(page, revisions))
+	return nil
+}
+
+// Diff prints a unified diff of a wiki page's content between two versions.
+func Diff(page string, oldVersion int, newVersion int) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	wikiID, err := resolveWikiID(client, page)
+	if err != nil {
+		return err
+	}
+
+	revisions, err := fetchHistory(client, wikiID)
+	if err != nil {
+		return err
+	}
+
+	oldRevision, err := findRevision(revisions, oldVersion)
+	if err != nil {
+		return err
+	}
+	newRevision, err := findRevision(revisions, newVersion)
+	if err != nil {
+		return err
+	}
+
+	printDiff(oldRevision, newRevision, render.Enabled())
+	return nil
+}
+
+// fetchHistory pages through all revisions of a wiki page in ascending
+// order.
+func fetchHistory(client *backlog.Client, wikiID string) ([]backlog.WikiRevision, error) {
+	query := url.Values{}
+	query.Set("count", "100")
+	query.Set("order", "asc")
+
+	revisions := []backlog.WikiRevision{}
+	seen := map[int]bool{}
+	for {
+		data, err := client.GetWikiHistory(wikiID, query)
+		if err != nil {
+			return nil, err
+		}
+		page, err := backlog.ParseWikiHistory(data)
+		if err != nil {
+			return nil, err
+		}
+
+		fetched := 0
+		for _, revision := range page {
+			if seen[revision.Version] {
+				continue
+			}
+			seen[revision.Version] = true
+			fetched++
+			revisions = append(revisions, revision)
+		}
+		if fetched == 0 {
+			break
+		}
+		query.Set("minId", strconv.Itoa(page[len(page)-1].Version))
+	}
+
+	return revisions, nil
+}
+
+// findRevision finds a revision by version number.
+func findRevision(revisions []backlog.WikiRevision, version int) (*backlog.WikiRevision, error) {
+	for i, revision := range revisions {
+		if revision.Version == version {
+			return &revisions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("wiki page version not found: %d", version)
+}
+
+// revisionUser returns the name of the user who saved a revision.
+func revisionUser(revision *backlog.WikiRevision) string {
+	if revision.CreatedUser == nil {
+		return "(unknown)"
+	}
+	return revision.CreatedUser.Name
+}
+
+// formatHistoryMarkdown formats the revisions as a Markdown list.
+func formatHistoryMarkdown(page string, revisions []backlog.WikiRevision) string {
+	var sb strings.Builder
+
+	if len(revisions) > 0 {
+		page = revisions[len(revisions)-1].Name
+	}
+	fmt.Fprintf(&sb, "## History of %s\n", page)
+	if len(revisions) == 0 {
+		sb.WriteString("\nNo revisions recorded.\n")
+		return sb.String()
+	}
+	for i := range revisions {
+		revision := &revisions[i]
+		fmt.Fprintf(&sb, "- v%d %s %s", revision.Version, locale.DateTimeString(revision.Created), revisionUser(revision))
+		if i > 0 && revisions[i-1].Name != revision.Name {
+			fmt.Fprintf(&sb, " (renamed from %s)", revisions[i-1].Name)
+		}
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// printDiff prints the content change between two revisions as a unified
+// diff, colored if color is set.
+func printDiff(oldRevision, newRevision *backlog.WikiRevision, color bool) {
+	paint := func(code string, s string) string {
+		if !color {
+			return s
+		}
+		return code + s + colorReset
+	}
+
+	fmt.Println(paint(colorBold, fmt.Sprintf("--- a/%s (v%d, %s %s)", oldRevision.Name, oldRevision.Version,
+		locale.DateTimeString(oldRevision.Created), revisionUser(oldRevision))))
+	fmt.Println(paint(colorBold, fmt.Sprintf("+++ b/%s (v%d, %s %s)", newRevision.Name, newRevision.Version,
+		locale.DateTimeString(newRevision.Created), revisionUser(newRevision))))
+	for _, hunk := range textdiff.Unified(oldRevision.Content, newRevision.Content, 3) {
+		fmt.Println(paint(colorCyan, hunk.Header()))
+		for _, line := range hunk.Lines {
+			text := string(line.Kind) + line.Text
+			switch line.Kind {
+			case textdiff.Delete:
+				text = paint(colorRed, text)
+			case textdiff.Insert:
+				text = paint(colorGreen, text)
+			}
+			fmt.Println(text)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package wiki
+
+import (
+	"net/url"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ListOptions contains options for the list command.
+type ListOptions struct {
+	Raw     bool
+	Keyword string
+}
+
+// List displays the wiki pages of a project, optionally filtered by a
+// keyword matched against page names and content.
+func List(projectIDOrKey string, opts ListOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	if opts.Keyword != "" {
+		query.Set("keyword", opts.Keyword)
+	}
+
+	data, err := client.GetWikis(projectIDOrKey, query)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	wikis, err := backlog.ParseWikis(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatWikisMarkdown(wikis)
+
+	render.Markdown(markdown)
+	return nil
+}
@@ -0,0 +1,169 @@
+package wiki
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// fetchWorkers is the number of pages fetched at once.
+const fetchWorkers = 4
+
+// snippetRadius is the number of characters shown on each side of a match.
+const snippetRadius = 60
+
+// SearchOptions contains options for the search command.
+type SearchOptions struct {
+	Raw bool
+}
+
+// searchResult is a page matching a search, with a snippet of its content
+// around the first match.
+type searchResult struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Snippet string `json:"snippet"`
+}
+
+// Search finds wiki pages whose name or content matches keyword and shows
+// each with a snippet of the content around the match.
+func Search(projectIDOrKey string, keyword string, opts SearchOptions) error {
+	if strings.TrimSpace(keyword) == "" {
+		return fmt.Errorf("search keyword cannot be empty")
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	query.Set("keyword", keyword)
+	data, err := client.GetWikis(projectIDOrKey, query)
+	if err != nil {
+		return err
+	}
+	wikis, err := backlog.ParseWikis(data)
+	if err != nil {
+		return err
+	}
+
+	// The page list has no content, so fetch each page for its snippet
+	results := make([]searchResult, len(wikis))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(fetchWorkers, len(wikis)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each worker has its own client, since a token refresh
+			// updates the client's config
+			worker, clientErr := backlog.NewClient()
+			for i := range jobs {
+				results[i] = searchResult{ID: wikis[i].ID, Name: wikis[i].Name}
+				if clientErr != nil {
+					continue
+				}
+				data, err := worker.GetWiki(strconv.Itoa(wikis[i].ID))
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Failed: %s: %v\n", wikis[i].Name, err)
+					continue
+				}
+				if wiki, err := backlog.ParseWiki(data); err == nil {
+					results[i].Snippet = snippet(wiki.Content, keyword)
+				}
+			}
+		}()
+	}
+	for i := range wikis {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if opts.Raw {
+		formatted, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(formatted))
+		return nil
+	}
+
+	if len(results) == 0 {
+		fmt.Printf("No wiki pages match %q.\n", keyword)
+		return nil
+	}
+
+	render.Markdown(formatSearchMarkdown(keyword, results))
+	return nil
+}
+
+// snippet returns the text around the first case-insensitive match of
+// keyword in content, on one line with the match in bold. If content has
+// no match (the page name matched instead), its beginning is returned.
+func snippet(content string, keyword string) string {
+	text := []rune(strings.Join(strings.Fields(content), " "))
+	needle := []rune(strings.ToLower(keyword))
+	lower := []rune(strings.ToLower(string(text)))
+
+	// Lowercasing can change rune counts; fall back to the beginning then
+	at := -1
+	if len(lower) == len(text) {
+		at = runeIndex(lower, needle)
+	}
+	if at < 0 {
+		if len(text) > 2*snippetRadius {
+			return string(text[:2*snippetRadius]) + "…"
+		}
+		return string(text)
+	}
+
+	start := max(at-snippetRadius, 0)
+	end := min(at+len(needle)+snippetRadius, len(text))
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString("…")
+	}
+	sb.WriteString(string(text[start:at]))
+	sb.WriteString("**" + string(text[at:at+len(needle)]) + "**")
+	sb.WriteString(string(text[at+len(needle) : end]))
+	if end < len(text) {
+		sb.WriteString("…")
+	}
+	return sb.String()
+}
+
+// runeIndex returns the index of the first occurrence of needle in s, or
+// -1 if there is none.
+func runeIndex(s []rune, needle []rune) int {
+	for i := 0; i+len(needle) <= len(s); i++ {
+		if string(s[i:i+len(needle)]) == string(needle) {
+			return i
+		}
+	}
+	return -1
+}
+
+// formatSearchMarkdown formats search results as Markdown.
+func formatSearchMarkdown(keyword string, results []searchResult) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "## %d wiki pages match %q\n", len(results), keyword)
+	for _, result := range results {
+		fmt.Fprintf(&sb, "- **%s** (id: %d)\n", result.Name, result.ID)
+		if result.Snippet != "" {
+			fmt.Fprintf(&sb, "  > %s\n", result.Snippet)
+		}
+	}
+
+	return sb.String()
+}
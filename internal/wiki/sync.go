@@ -0,0 +1,153 @@
+package wiki
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/prompt"
+	"github.com/dannygim/bgl/internal/secrets"
+)
+
+// SyncOptions contains options for the sync command.
+type SyncOptions struct {
+	Yes    bool
+	Force  bool
+	DryRun bool
+}
+
+// Sync pushes local edits in an export directory back to the wiki. Only
+// files whose content differs from the manifest are pushed. A page that
+// was also changed remotely since the export is skipped unless Force is
+// set, so remote edits are not overwritten by accident. The manifest is
+// updated with the pushed versions.
+func Sync(dir string, opts SyncOptions) error {
+	manifest, err := readManifest(dir)
+	if err != nil {
+		return err
+	}
+
+	type change struct {
+		entry   *ManifestEntry
+		content string
+	}
+	var changes []change
+	for i := range manifest.Pages {
+		entry := &manifest.Pages[i]
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(entry.File)))
+		if err != nil {
+			if os.IsNotExist(err) {
+				fmt.Fprintf(os.Stderr, "Skipped: %s (file removed; delete pages with 'bgl wiki delete')\n", entry.File)
+				continue
+			}
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+		content := strings.TrimRight(string(data), "\n")
+		if contentHash(content) == entry.Hash {
+			continue
+		}
+		if err := secrets.Check(content); err != nil {
+			return fmt.Errorf("%s: %w", entry.File, err)
+		}
+		changes = append(changes, change{entry, content})
+	}
+
+	if len(changes) == 0 {
+		fmt.Println("No local changes.")
+		return nil
+	}
+
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	if client.GetSpace() != manifest.Space {
+		return fmt.Errorf("%s was exported from %s, but the current space is %s", dir, manifest.Space, client.GetSpace())
+	}
+
+	details := []string{"Space: " + manifest.Space, "Project: " + manifest.Project}
+	for _, c := range changes {
+		details = append(details, "Page: "+c.entry.Name)
+	}
+
+	if opts.DryRun {
+		fmt.Println(strings.Join(details, "\n"))
+		return nil
+	}
+
+	// Show confirmation unless --yes is specified
+	if !opts.Yes {
+		ok, err := prompt.Confirm("Push Wiki Changes?", "Push", details)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("Cancelled.")
+			return nil
+		}
+	}
+
+	// On Ctrl-C, finish the page being pushed and stop. The manifest is
+	// saved after every push, so the next sync picks up the rest.
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt)
+	defer signal.Stop(interrupted)
+
+	skipped := 0
+	for i, c := range changes {
+		select {
+		case <-interrupted:
+			fmt.Printf("Interrupted before %s, after %d of %d changed page(s). Run 'bgl wiki sync' again to push the rest.\n",
+				c.entry.File, i, len(changes))
+			return nil
+		default:
+		}
+
+		wikiID := strconv.Itoa(c.entry.ID)
+
+		// Check whether someone else saved the page since it was exported
+		data, err := client.GetWiki(wikiID)
+		if err != nil {
+			return err
+		}
+		latest, err := backlog.ParseWiki(data)
+		if err != nil {
+			return err
+		}
+		if latest.Updated != c.entry.Updated && !opts.Force {
+			fmt.Fprintf(os.Stderr, "Skipped: %s (changed remotely since the export; use --force to overwrite)\n", c.entry.File)
+			skipped++
+			continue
+		}
+
+		update := url.Values{}
+		update.Set("content", c.content)
+		result, err := client.UpdateWiki(wikiID, update)
+		if err != nil {
+			return err
+		}
+		wiki, err := backlog.ParseWiki(result)
+		if err != nil {
+			return err
+		}
+
+		c.entry.Name = wiki.Name
+		c.entry.Updated = wiki.Updated
+		c.entry.Hash = contentHash(c.content)
+		if err := writeManifest(dir, manifest); err != nil {
+			return err
+		}
+		fmt.Printf("Pushed: %s\n", c.entry.File)
+	}
+
+	if skipped > 0 {
+		return fmt.Errorf("%d of %d changed pages were skipped", skipped, len(changes))
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package wiki
+
+import (
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// TagsOptions contains options for the tags command.
+type TagsOptions struct {
+	Raw bool
+}
+
+// Tags lists the wiki tags used in a project.
+func Tags(projectIDOrKey string, opts TagsOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetWikiTags(projectIDOrKey)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	tags, err := backlog.ParseWikiTags(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatWikiTagsMarkdown(tags)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// untaggedName removes the [tag] prefix of each tag from a page name.
+func untaggedName(name string, tags []string) string {
+	for _, tag := range tags {
+		name = strings.ReplaceAll(name, "["+tag+"]", "")
+	}
+	return strings.TrimSpace(name)
+}
@@ -0,0 +1,76 @@
+package wiki
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dannygim/bgl/internal/backlog"
+	"github.com/dannygim/bgl/internal/render"
+)
+
+// ViewOptions contains options for the view command.
+type ViewOptions struct {
+	Raw bool
+}
+
+// View displays a wiki page by its ID or by PROJ:PageName.
+func View(page string, opts ViewOptions) error {
+	client, err := backlog.NewClient()
+	if err != nil {
+		return err
+	}
+
+	wikiID, err := resolveWikiID(client, page)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.GetWiki(wikiID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Raw {
+		render.JSON(data)
+		return nil
+	}
+
+	wiki, err := backlog.ParseWiki(data)
+	if err != nil {
+		return err
+	}
+
+	markdown := backlog.FormatWikiMarkdown(wiki)
+
+	render.Markdown(markdown)
+	return nil
+}
+
+// resolveWikiID turns a page reference into a wiki page ID. A numeric
+// reference is used as is; PROJ:PageName is looked up in the project's
+// page list by name.
+func resolveWikiID(client *backlog.Client, page string) (string, error) {
+	if _, err := strconv.Atoi(page); err == nil {
+		return page, nil
+	}
+
+	projectKey, name, ok := strings.Cut(page, ":")
+	if !ok || projectKey == "" || name == "" {
+		return "", fmt.Errorf("invalid wiki page %q (expected a page ID or PROJ:PageName)", page)
+	}
+
+	data, err := client.GetWikis(projectKey, nil)
+	if err != nil {
+		return "", err
+	}
+	wikis, err := backlog.ParseWikis(data)
+	if err != nil {
+		return "", err
+	}
+	wiki, err := backlog.FindWiki(wikis, name)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(wiki.ID), nil
+}